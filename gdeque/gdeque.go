@@ -0,0 +1,178 @@
+package gdeque
+
+// ring is a circular buffer shared by Deque and RingBuffer
+type ring[T any] struct {
+	buf  []T
+	head int
+	size int
+}
+
+func (r *ring[T]) index(i int) int {
+	return (r.head + i) % len(r.buf)
+}
+
+func (r *ring[T]) at(i int) (T, bool) {
+	if i < 0 || i >= r.size {
+		var zeroValue T
+		return zeroValue, false
+	}
+	return r.buf[r.index(i)], true
+}
+
+func (r *ring[T]) set(i int, v T) bool {
+	if i < 0 || i >= r.size {
+		return false
+	}
+	r.buf[r.index(i)] = v
+	return true
+}
+
+// pushBack requires a free slot
+func (r *ring[T]) pushBack(v T) {
+	r.buf[r.index(r.size)] = v
+	r.size++
+}
+
+// pushFront requires a free slot
+func (r *ring[T]) pushFront(v T) {
+	r.head = (r.head - 1 + len(r.buf)) % len(r.buf)
+	r.buf[r.head] = v
+	r.size++
+}
+
+func (r *ring[T]) popFront() (T, bool) {
+	var zeroValue T
+	if r.size == 0 {
+		return zeroValue, false
+	}
+	v := r.buf[r.head]
+	r.buf[r.head] = zeroValue // release the reference for GC
+	r.head = (r.head + 1) % len(r.buf)
+	r.size--
+	return v, true
+}
+
+func (r *ring[T]) popBack() (T, bool) {
+	var zeroValue T
+	if r.size == 0 {
+		return zeroValue, false
+	}
+	i := r.index(r.size - 1)
+	v := r.buf[i]
+	r.buf[i] = zeroValue
+	r.size--
+	return v, true
+}
+
+// toSlice copies the elements from front to back into a new slice with the given capacity
+func (r *ring[T]) toSlice(capacity int) []T {
+	result := make([]T, r.size, capacity)
+	if r.size == 0 {
+		return result
+	}
+	n := copy(result, r.buf[r.head:])
+	if n < r.size {
+		copy(result[n:], r.buf[:r.size-n])
+	}
+	return result
+}
+
+func (r *ring[T]) clear() {
+	var zeroValue T
+	for i := range r.buf {
+		r.buf[i] = zeroValue
+	}
+	r.head, r.size = 0, 0
+}
+
+// Deque is a double-ended queue backed by a growable ring buffer,
+// pushing and popping at both ends is amortized O(1) and indexed access is O(1)
+//
+// the zero value is an empty deque ready to use.
+type Deque[T any] struct {
+	r ring[T]
+}
+
+// New creates an empty deque
+func New[T any]() *Deque[T] {
+	return &Deque[T]{}
+}
+
+// FromSlice creates a deque containing the elements of slice, slice is not modified
+func FromSlice[T any](slice []T) *Deque[T] {
+	buf := make([]T, len(slice))
+	copy(buf, slice)
+	return &Deque[T]{r: ring[T]{buf: buf, size: len(slice)}}
+}
+
+// Len return the number of elements in the deque
+func (d *Deque[T]) Len() int {
+	return d.r.size
+}
+
+// PushBack adds v to the back of the deque
+func (d *Deque[T]) PushBack(v T) {
+	d.grow()
+	d.r.pushBack(v)
+}
+
+// PushFront adds v to the front of the deque
+func (d *Deque[T]) PushFront(v T) {
+	d.grow()
+	d.r.pushFront(v)
+}
+
+// PopFront removes and returns the front element, or false if the deque is empty
+func (d *Deque[T]) PopFront() (T, bool) {
+	return d.r.popFront()
+}
+
+// PopBack removes and returns the back element, or false if the deque is empty
+func (d *Deque[T]) PopBack() (T, bool) {
+	return d.r.popBack()
+}
+
+// Front return the front element, or false if the deque is empty
+func (d *Deque[T]) Front() (T, bool) {
+	return d.r.at(0)
+}
+
+// Back return the back element, or false if the deque is empty
+func (d *Deque[T]) Back() (T, bool) {
+	return d.r.at(d.r.size - 1)
+}
+
+// At return the i-th element from the front, or false if i is out of range
+func (d *Deque[T]) At(i int) (T, bool) {
+	return d.r.at(i)
+}
+
+// Set replaces the i-th element from the front, it return false if i is out of range
+func (d *Deque[T]) Set(i int, v T) bool {
+	return d.r.set(i, v)
+}
+
+// Clear removes all elements and keeps the allocated buffer
+func (d *Deque[T]) Clear() {
+	d.r.clear()
+}
+
+// ToSlice return the elements from front to back as a new slice
+func (d *Deque[T]) ToSlice() []T {
+	return d.r.toSlice(d.r.size)
+}
+
+// grow makes sure there is room for one more element
+func (d *Deque[T]) grow() {
+	if d.r.size < len(d.r.buf) {
+		return
+	}
+
+	capacity := 2 * len(d.r.buf)
+	if capacity < 8 {
+		capacity = 8
+	}
+	buf := d.r.toSlice(capacity)
+	d.r.buf = buf[:capacity]
+	d.r.head = 0
+}
@@ -0,0 +1,125 @@
+package gdeque
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDequePushPop(t *testing.T) {
+	d := New[int]()
+	d.PushBack(2)
+	d.PushBack(3)
+	d.PushFront(1)
+	d.PushFront(0)
+
+	if expected := []int{0, 1, 2, 3}; !reflect.DeepEqual(d.ToSlice(), expected) {
+		t.Errorf("Expected %v, got %v", expected, d.ToSlice())
+	}
+
+	if v, ok := d.PopFront(); !ok || v != 0 {
+		t.Errorf("PopFront was incorrect, got %v, %v", v, ok)
+	}
+	if v, ok := d.PopBack(); !ok || v != 3 {
+		t.Errorf("PopBack was incorrect, got %v, %v", v, ok)
+	}
+	if v, ok := d.Front(); !ok || v != 1 {
+		t.Errorf("Front was incorrect, got %v, %v", v, ok)
+	}
+	if v, ok := d.Back(); !ok || v != 2 {
+		t.Errorf("Back was incorrect, got %v, %v", v, ok)
+	}
+	if d.Len() != 2 {
+		t.Errorf("Expected 2 elements, got %d", d.Len())
+	}
+}
+
+func TestDequeEmpty(t *testing.T) {
+	var d Deque[string]
+	if _, ok := d.PopFront(); ok {
+		t.Errorf("PopFront on empty deque should return false")
+	}
+	if _, ok := d.PopBack(); ok {
+		t.Errorf("PopBack on empty deque should return false")
+	}
+	if _, ok := d.Front(); ok {
+		t.Errorf("Front on empty deque should return false")
+	}
+	if _, ok := d.Back(); ok {
+		t.Errorf("Back on empty deque should return false")
+	}
+	if result := d.ToSlice(); !reflect.DeepEqual(result, []string{}) {
+		t.Errorf("Expected empty slice, got %v", result)
+	}
+
+	d.PushBack("a")
+	if v, _ := d.Front(); v != "a" {
+		t.Errorf("zero value deque should be usable, got %v", v)
+	}
+}
+
+func TestDequeGrowWrapped(t *testing.T) {
+	d := New[int]()
+	expected := make([]int, 0)
+	// mix both ends so the ring wraps around before growing
+	for i := 0; i < 100; i++ {
+		if i%3 == 0 {
+			d.PushFront(i)
+			expected = append([]int{i}, expected...)
+		} else {
+			d.PushBack(i)
+			expected = append(expected, i)
+		}
+	}
+	if !reflect.DeepEqual(d.ToSlice(), expected) {
+		t.Errorf("Expected %v, got %v", expected, d.ToSlice())
+	}
+
+	for i, v := range expected {
+		if got, ok := d.At(i); !ok || got != v {
+			t.Errorf("At(%d) = %v, expected %v", i, got, v)
+		}
+	}
+}
+
+func TestDequeAtSet(t *testing.T) {
+	d := FromSlice([]int{1, 2, 3})
+	if !d.Set(1, 20) {
+		t.Errorf("Set should succeed")
+	}
+	if v, _ := d.At(1); v != 20 {
+		t.Errorf("Expected 20, got %v", v)
+	}
+	if d.Set(3, 0) || d.Set(-1, 0) {
+		t.Errorf("Set out of range should fail")
+	}
+	if _, ok := d.At(3); ok {
+		t.Errorf("At out of range should fail")
+	}
+}
+
+func TestDequeFromSlice(t *testing.T) {
+	input := []int{1, 2, 3}
+	d := FromSlice(input)
+	d.PushFront(0)
+	d.Set(1, 10)
+
+	if !reflect.DeepEqual(input, []int{1, 2, 3}) {
+		t.Errorf("FromSlice should copy the input, got %v", input)
+	}
+	if expected := []int{0, 10, 2, 3}; !reflect.DeepEqual(d.ToSlice(), expected) {
+		t.Errorf("Expected %v, got %v", expected, d.ToSlice())
+	}
+}
+
+func TestDequeClear(t *testing.T) {
+	d := FromSlice([]*int{new(int), new(int)})
+	d.Clear()
+	if d.Len() != 0 {
+		t.Errorf("Expected empty deque, got %d elements", d.Len())
+	}
+	for _, p := range d.r.buf {
+		if p != nil {
+			t.Errorf("Clear should release references")
+		}
+	}
+}
@@ -0,0 +1,88 @@
+package gdeque
+
+// FullPolicy decides what RingBuffer.Push does when the buffer is full
+type FullPolicy int
+
+const (
+	// OverwriteOldest drops the oldest element to make room for the new one
+	OverwriteOldest FullPolicy = iota
+	// RejectNew keeps the buffer unchanged and rejects the new element
+	RejectNew
+)
+
+// RingBuffer is a FIFO queue with a fixed capacity, it never allocates after creation
+//
+// unlike Deque the zero value is not usable: it has no capacity and rejects every element,
+// create a RingBuffer with NewRingBuffer.
+type RingBuffer[T any] struct {
+	r      ring[T]
+	policy FullPolicy
+}
+
+// NewRingBuffer creates an empty ring buffer which holds at most capacity elements,
+// capacity < 1 is treated as 1
+func NewRingBuffer[T any](capacity int, policy FullPolicy) *RingBuffer[T] {
+	if capacity < 1 {
+		capacity = 1
+	}
+	return &RingBuffer[T]{
+		r:      ring[T]{buf: make([]T, capacity)},
+		policy: policy,
+	}
+}
+
+// Push adds v as the newest element, it return false if v was rejected because the buffer is full
+// and the policy is RejectNew
+func (b *RingBuffer[T]) Push(v T) bool {
+	if len(b.r.buf) == 0 {
+		return false
+	}
+	if b.Full() {
+		if b.policy == RejectNew {
+			return false
+		}
+		b.r.popFront()
+	}
+	b.r.pushBack(v)
+	return true
+}
+
+// Pop removes and returns the oldest element, or false if the buffer is empty
+func (b *RingBuffer[T]) Pop() (T, bool) {
+	return b.r.popFront()
+}
+
+// Peek return the oldest element, or false if the buffer is empty
+func (b *RingBuffer[T]) Peek() (T, bool) {
+	return b.r.at(0)
+}
+
+// At return the i-th oldest element, or false if i is out of range
+func (b *RingBuffer[T]) At(i int) (T, bool) {
+	return b.r.at(i)
+}
+
+// Len return the number of elements in the buffer
+func (b *RingBuffer[T]) Len() int {
+	return b.r.size
+}
+
+// Cap return the capacity of the buffer
+func (b *RingBuffer[T]) Cap() int {
+	return len(b.r.buf)
+}
+
+// Full return true if the buffer holds Cap() elements
+func (b *RingBuffer[T]) Full() bool {
+	return b.r.size == len(b.r.buf)
+}
+
+// Clear removes all elements
+func (b *RingBuffer[T]) Clear() {
+	b.r.clear()
+}
+
+// ToSlice return the elements from oldest to newest as a new slice
+func (b *RingBuffer[T]) ToSlice() []T {
+	return b.r.toSlice(b.r.size)
+}
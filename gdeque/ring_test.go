@@ -0,0 +1,85 @@
+package gdeque
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRingBufferOverwrite(t *testing.T) {
+	b := NewRingBuffer[int](3, OverwriteOldest)
+	for i := 1; i <= 5; i++ {
+		if !b.Push(i) {
+			t.Errorf("Push should always succeed with OverwriteOldest")
+		}
+	}
+
+	if expected := []int{3, 4, 5}; !reflect.DeepEqual(b.ToSlice(), expected) {
+		t.Errorf("Expected %v, got %v", expected, b.ToSlice())
+	}
+	if !b.Full() || b.Len() != 3 || b.Cap() != 3 {
+		t.Errorf("Expected a full buffer of 3, got len %d cap %d", b.Len(), b.Cap())
+	}
+	if v, ok := b.Peek(); !ok || v != 3 {
+		t.Errorf("Peek was incorrect, got %v, %v", v, ok)
+	}
+	if v, ok := b.At(2); !ok || v != 5 {
+		t.Errorf("At was incorrect, got %v, %v", v, ok)
+	}
+}
+
+func TestRingBufferReject(t *testing.T) {
+	b := NewRingBuffer[string](2, RejectNew)
+	b.Push("a")
+	b.Push("b")
+	if b.Push("c") {
+		t.Errorf("Push should be rejected when full")
+	}
+	if expected := []string{"a", "b"}; !reflect.DeepEqual(b.ToSlice(), expected) {
+		t.Errorf("Expected %v, got %v", expected, b.ToSlice())
+	}
+
+	if v, ok := b.Pop(); !ok || v != "a" {
+		t.Errorf("Pop was incorrect, got %v, %v", v, ok)
+	}
+	if !b.Push("c") {
+		t.Errorf("Push should succeed after Pop")
+	}
+	if expected := []string{"b", "c"}; !reflect.DeepEqual(b.ToSlice(), expected) {
+		t.Errorf("Expected %v, got %v", expected, b.ToSlice())
+	}
+}
+
+func TestRingBufferEmpty(t *testing.T) {
+	b := NewRingBuffer[int](0, RejectNew)
+	if b.Cap() != 1 {
+		t.Errorf("Expected capacity 1, got %d", b.Cap())
+	}
+	if _, ok := b.Pop(); ok {
+		t.Errorf("Pop on empty buffer should return false")
+	}
+	if _, ok := b.Peek(); ok {
+		t.Errorf("Peek on empty buffer should return false")
+	}
+
+	b.Push(1)
+	b.Clear()
+	if b.Len() != 0 || !reflect.DeepEqual(b.ToSlice(), []int{}) {
+		t.Errorf("Expected empty buffer after Clear, got %v", b.ToSlice())
+	}
+}
+
+func TestRingBufferZeroValue(t *testing.T) {
+	var b RingBuffer[int]
+	if b.Push(1) {
+		t.Errorf("the zero value should reject every element")
+	}
+	if b.Len() != 0 || b.Cap() != 0 {
+		t.Errorf("Expected an empty buffer without capacity, got len %d cap %d", b.Len(), b.Cap())
+	}
+	if _, ok := b.Pop(); ok {
+		t.Errorf("Pop should fail on the zero value")
+	}
+	if result := b.ToSlice(); len(result) != 0 {
+		t.Errorf("Expected empty slice, got %v", result)
+	}
+}
@@ -0,0 +1,196 @@
+package gheap
+
+import (
+	"github.com/arcsinw/gg/gslice"
+)
+
+// Handle refers to an element pushed into a Heap, it stays valid while the element is in the heap
+// and can be used to update or remove the element (e.g. decrease-key in Dijkstra's algorithm)
+type Handle[T any] struct {
+	value T
+	index int
+}
+
+// Value return the value of the element
+func (h *Handle[T]) Value() T {
+	return h.value
+}
+
+// Heap is a priority queue ordered by a less function, Pop returns the smallest element by less
+//
+// the zero value is not usable, create a Heap with New, From, NewMin or NewMax.
+type Heap[T any] struct {
+	items []*Handle[T]
+	less  func(T, T) bool
+}
+
+// New creates an empty heap ordered by less, the same less function as gslice.OrderBy
+func New[T any](less func(T, T) bool) *Heap[T] {
+	return &Heap[T]{
+		items: make([]*Handle[T], 0),
+		less:  less,
+	}
+}
+
+// From creates a heap containing the elements of slice in O(n), slice is not modified
+//
+// the handles of the elements are returned in the order of slice, so they can be updated or removed later.
+func From[T any](slice []T, less func(T, T) bool) (*Heap[T], []*Handle[T]) {
+	h := &Heap[T]{
+		items: make([]*Handle[T], len(slice)),
+		less:  less,
+	}
+	handles := make([]*Handle[T], len(slice))
+	for i, v := range slice {
+		handles[i] = &Handle[T]{value: v, index: i}
+	}
+	copy(h.items, handles)
+	for i := len(h.items)/2 - 1; i >= 0; i-- {
+		h.down(i)
+	}
+	return h, handles
+}
+
+// NewMin creates an empty heap which pops the smallest element first
+func NewMin[T gslice.Ordered]() *Heap[T] {
+	return New(func(a, b T) bool { return a < b })
+}
+
+// NewMax creates an empty heap which pops the greatest element first
+func NewMax[T gslice.Ordered]() *Heap[T] {
+	return New(func(a, b T) bool { return a > b })
+}
+
+// Len return the number of elements in the heap
+func (h *Heap[T]) Len() int {
+	return len(h.items)
+}
+
+// Push adds v to the heap and returns its handle
+func (h *Heap[T]) Push(v T) *Handle[T] {
+	item := &Handle[T]{value: v, index: len(h.items)}
+	h.items = append(h.items, item)
+	h.up(item.index)
+	return item
+}
+
+// Peek return the smallest element without removing it, or false if the heap is empty
+func (h *Heap[T]) Peek() (T, bool) {
+	if len(h.items) == 0 {
+		var zeroValue T
+		return zeroValue, false
+	}
+	return h.items[0].value, true
+}
+
+// Pop removes and returns the smallest element, or false if the heap is empty
+func (h *Heap[T]) Pop() (T, bool) {
+	if len(h.items) == 0 {
+		var zeroValue T
+		return zeroValue, false
+	}
+	return h.removeAt(0), true
+}
+
+// Update sets the value of the element referred by handle and restores the heap order,
+// it return false if the handle doesn't belong to the heap
+func (h *Heap[T]) Update(handle *Handle[T], v T) bool {
+	if !h.owns(handle) {
+		return false
+	}
+	handle.value = v
+	h.fix(handle.index)
+	return true
+}
+
+// Fix restores the heap order after the priority of the element referred by handle changed outside the heap
+// (e.g. a field of a pointer element was modified), it return false if the handle doesn't belong to the heap
+func (h *Heap[T]) Fix(handle *Handle[T]) bool {
+	if !h.owns(handle) {
+		return false
+	}
+	h.fix(handle.index)
+	return true
+}
+
+// Remove removes the element referred by handle and returns its value,
+// or false if the handle doesn't belong to the heap
+func (h *Heap[T]) Remove(handle *Handle[T]) (T, bool) {
+	if !h.owns(handle) {
+		var zeroValue T
+		return zeroValue, false
+	}
+	return h.removeAt(handle.index), true
+}
+
+// Values return the elements of the heap in heap order (not sorted)
+func (h *Heap[T]) Values() []T {
+	result := make([]T, len(h.items))
+	for i, item := range h.items {
+		result[i] = item.value
+	}
+	return result
+}
+
+func (h *Heap[T]) owns(handle *Handle[T]) bool {
+	return handle != nil && handle.index >= 0 && handle.index < len(h.items) && h.items[handle.index] == handle
+}
+
+func (h *Heap[T]) removeAt(i int) T {
+	item := h.items[i]
+	last := len(h.items) - 1
+	if i != last {
+		h.swap(i, last)
+	}
+	h.items[last] = nil
+	h.items = h.items[:last]
+	if i != last {
+		h.fix(i)
+	}
+
+	item.index = -1
+	return item.value
+}
+
+func (h *Heap[T]) fix(i int) {
+	if !h.down(i) {
+		h.up(i)
+	}
+}
+
+func (h *Heap[T]) up(i int) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !h.less(h.items[i].value, h.items[parent].value) {
+			return
+		}
+		h.swap(i, parent)
+		i = parent
+	}
+}
+
+// down moves the element at i down and reports whether it moved
+func (h *Heap[T]) down(i int) bool {
+	start := i
+	for {
+		smallest := i
+		left, right := 2*i+1, 2*i+2
+		if left < len(h.items) && h.less(h.items[left].value, h.items[smallest].value) {
+			smallest = left
+		}
+		if right < len(h.items) && h.less(h.items[right].value, h.items[smallest].value) {
+			smallest = right
+		}
+		if smallest == i {
+			return i > start
+		}
+		h.swap(i, smallest)
+		i = smallest
+	}
+}
+
+func (h *Heap[T]) swap(i, j int) {
+	h.items[i], h.items[j] = h.items[j], h.items[i]
+	h.items[i].index = i
+	h.items[j].index = j
+}
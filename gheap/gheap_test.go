@@ -0,0 +1,238 @@
+package gheap
+
+import (
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func popAll[T any](h *Heap[T]) []T {
+	result := make([]T, 0)
+	for h.Len() > 0 {
+		v, _ := h.Pop()
+		result = append(result, v)
+	}
+	return result
+}
+
+func TestPushPop(t *testing.T) {
+	h := NewMin[int]()
+	for _, v := range []int{5, 1, 4, 1, 3} {
+		h.Push(v)
+	}
+
+	if v, ok := h.Peek(); !ok || v != 1 {
+		t.Errorf("Peek was incorrect, got %v, %v", v, ok)
+	}
+	if h.Len() != 5 {
+		t.Errorf("Expected 5 elements, got %d", h.Len())
+	}
+
+	result := popAll(h)
+	if expected := []int{1, 1, 3, 4, 5}; !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+
+	if _, ok := h.Pop(); ok {
+		t.Errorf("Pop on empty heap should return false")
+	}
+	if _, ok := h.Peek(); ok {
+		t.Errorf("Peek on empty heap should return false")
+	}
+}
+
+func TestNewMax(t *testing.T) {
+	h := NewMax[string]()
+	for _, v := range []string{"b", "c", "a"} {
+		h.Push(v)
+	}
+	if result := popAll(h); !reflect.DeepEqual(result, []string{"c", "b", "a"}) {
+		t.Errorf("Expected [c b a], got %v", result)
+	}
+}
+
+func TestFrom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	input := make([]int, 100)
+	for i := range input {
+		input[i] = r.Intn(50)
+	}
+	original := append([]int{}, input...)
+
+	h, _ := From(input, func(a, b int) bool { return a < b })
+	result := popAll(h)
+
+	sort.Ints(original)
+	if !reflect.DeepEqual(result, original) {
+		t.Errorf("Expected %v, got %v", original, result)
+	}
+
+	if h, handles := From([]int{}, func(a, b int) bool { return a < b }); h.Len() != 0 || len(handles) != 0 {
+		t.Errorf("Expected empty heap")
+	}
+}
+
+func TestFromHandles(t *testing.T) {
+	input := []int{5, 3, 8, 1}
+	h, handles := From(input, func(a, b int) bool { return a < b })
+	for i, handle := range handles {
+		if handle.Value() != input[i] {
+			t.Errorf("Expected handle %d to hold %d, got %d", i, input[i], handle.Value())
+		}
+	}
+
+	// decrease-key on an element loaded by From
+	if !h.Update(handles[2], 0) {
+		t.Fatalf("Update should accept a handle returned by From")
+	}
+	if v, ok := h.Remove(handles[1]); !ok || v != 3 {
+		t.Errorf("Expected to remove 3, got %v %v", v, ok)
+	}
+	if result := popAll(h); !reflect.DeepEqual(result, []int{0, 1, 5}) {
+		t.Errorf("Expected [0 1 5], got %v", result)
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type task struct {
+		name     string
+		priority int
+	}
+	h := New(func(a, b task) bool { return a.priority < b.priority })
+	h.Push(task{"a", 5})
+	b := h.Push(task{"b", 10})
+	h.Push(task{"c", 7})
+
+	if !h.Update(b, task{"b", 1}) {
+		t.Fatalf("Update should succeed")
+	}
+	if v, _ := h.Peek(); v.name != "b" {
+		t.Errorf("Expected b on top after decrease-key, got %v", v)
+	}
+
+	if !h.Update(b, task{"b", 100}) {
+		t.Fatalf("Update should succeed")
+	}
+	names := make([]string, 0)
+	for _, v := range popAll(h) {
+		names = append(names, v.name)
+	}
+	if expected := []string{"a", "c", "b"}; !reflect.DeepEqual(names, expected) {
+		t.Errorf("Expected %v, got %v", expected, names)
+	}
+
+	if h.Update(b, task{"b", 0}) {
+		t.Errorf("Update with a popped handle should fail")
+	}
+}
+
+func TestRemove(t *testing.T) {
+	h := NewMin[int]()
+	handles := make([]*Handle[int], 0)
+	for _, v := range []int{4, 2, 8, 6, 1} {
+		handles = append(handles, h.Push(v))
+	}
+
+	if v, ok := h.Remove(handles[2]); !ok || v != 8 {
+		t.Errorf("Remove was incorrect, got %v, %v", v, ok)
+	}
+	if v, ok := h.Remove(handles[4]); !ok || v != 1 {
+		t.Errorf("Remove was incorrect, got %v, %v", v, ok)
+	}
+	if _, ok := h.Remove(handles[4]); ok {
+		t.Errorf("Remove twice should fail")
+	}
+	if handles[0].Value() != 4 {
+		t.Errorf("Value was incorrect, got %v", handles[0].Value())
+	}
+
+	if result := popAll(h); !reflect.DeepEqual(result, []int{2, 4, 6}) {
+		t.Errorf("Expected [2 4 6], got %v", result)
+	}
+
+	other := NewMin[int]()
+	handle := other.Push(1)
+	h.Push(1)
+	if _, ok := h.Remove(handle); ok {
+		t.Errorf("Remove with a handle from another heap should fail")
+	}
+	if _, ok := h.Remove(nil); ok {
+		t.Errorf("Remove with nil handle should fail")
+	}
+}
+
+func TestDijkstra(t *testing.T) {
+	type edge struct{ to, weight int }
+	graph := map[int][]edge{
+		0: {{1, 4}, {2, 1}},
+		2: {{1, 2}, {3, 5}},
+		1: {{3, 1}},
+	}
+
+	dist := map[int]int{0: 0}
+	handles := map[int]*Handle[int]{}
+	h := New(func(a, b int) bool { return dist[a] < dist[b] })
+	handles[0] = h.Push(0)
+
+	for h.Len() > 0 {
+		u, _ := h.Pop()
+		for _, e := range graph[u] {
+			d := dist[u] + e.weight
+			if old, ok := dist[e.to]; ok && old <= d {
+				continue
+			}
+			dist[e.to] = d
+			if handle, ok := handles[e.to]; ok && h.Update(handle, e.to) {
+				continue
+			}
+			handles[e.to] = h.Push(e.to)
+		}
+	}
+
+	if expected := map[int]int{0: 0, 1: 3, 2: 1, 3: 4}; !reflect.DeepEqual(dist, expected) {
+		t.Errorf("Expected %v, got %v", expected, dist)
+	}
+}
+
+func TestValues(t *testing.T) {
+	h, _ := From([]int{3, 1, 2}, func(a, b int) bool { return a < b })
+	values := h.Values()
+	if values[0] != 1 || len(values) != 3 {
+		t.Errorf("Values was incorrect, got %v", values)
+	}
+}
+
+func TestFix(t *testing.T) {
+	type job struct{ priority int }
+	h := New(func(a, b *job) bool { return a.priority < b.priority })
+	a := &job{1}
+	b := &job{2}
+	h.Push(a)
+	handle := h.Push(b)
+
+	b.priority = 0
+	if !h.Fix(handle) {
+		t.Fatalf("Fix should succeed")
+	}
+	if v, _ := h.Peek(); v != b {
+		t.Errorf("Expected b on top after Fix, got %v", v)
+	}
+
+	h.Pop()
+	if h.Fix(handle) {
+		t.Errorf("Fix with a popped handle should fail")
+	}
+}
+
+type cents int64
+
+func TestNamedType(t *testing.T) {
+	h := NewMax[cents]()
+	h.Push(100)
+	h.Push(300)
+	h.Push(200)
+	if result := popAll(h); !reflect.DeepEqual(result, []cents{300, 200, 100}) {
+		t.Errorf("Expected [300 200 100], got %v", result)
+	}
+}
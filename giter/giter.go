@@ -0,0 +1,309 @@
+// Package giter provides lazy sequence combinators.
+//
+// Seq and Seq2 have the same shape as iter.Seq and iter.Seq2, so with Go 1.23 or later
+// they can be used directly in a for-range loop:
+//
+//	for v := range giter.Filter(giter.FromSlice(s), isEven) {
+//		...
+//	}
+//
+// every combinator is lazy, elements are pulled one by one and no intermediate slice is allocated
+// until Collect (or another terminal function) is called.
+package giter
+
+// Seq is an iterator over a sequence of values
+type Seq[T any] func(yield func(T) bool)
+
+// Seq2 is an iterator over a sequence of pairs of values
+type Seq2[K, V any] func(yield func(K, V) bool)
+
+// FromSlice return a sequence of the elements in slice
+func FromSlice[T any](slice []T) Seq[T] {
+	return func(yield func(T) bool) {
+		for _, v := range slice {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Enumerate return a sequence of index-value pairs of the elements in slice
+func Enumerate[T any](slice []T) Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i, v := range slice {
+			if !yield(i, v) {
+				return
+			}
+		}
+	}
+}
+
+// FromMap return a sequence of key-value pairs of map (in random order)
+func FromMap[K comparable, V any](m map[K]V) Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for k, v := range m {
+			if !yield(k, v) {
+				return
+			}
+		}
+	}
+}
+
+// Keys return a sequence of the keys of map (in random order)
+func Keys[K comparable, V any](m map[K]V) Seq[K] {
+	return func(yield func(K) bool) {
+		for k := range m {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+// Values return a sequence of the values of map (in random order)
+func Values[K comparable, V any](m map[K]V) Seq[V] {
+	return func(yield func(V) bool) {
+		for _, v := range m {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Collect collects the values of seq into a new slice
+func Collect[T any](seq Seq[T]) []T {
+	result := make([]T, 0)
+	seq(func(v T) bool {
+		result = append(result, v)
+		return true
+	})
+	return result
+}
+
+// CollectMap collects the key-value pairs of seq into a new map
+//
+// if the same key appears more than once, the last value is used.
+func CollectMap[K comparable, V any](seq Seq2[K, V]) map[K]V {
+	result := make(map[K]V)
+	seq(func(k K, v V) bool {
+		result[k] = v
+		return true
+	})
+	return result
+}
+
+// Map apply function f to each element of seq
+func Map[T, U any](seq Seq[T], f func(T) U) Seq[U] {
+	return func(yield func(U) bool) {
+		seq(func(v T) bool {
+			return yield(f(v))
+		})
+	}
+}
+
+// Filter return elements in seq that match the given condition
+func Filter[T any](seq Seq[T], f func(T) bool) Seq[T] {
+	return func(yield func(T) bool) {
+		seq(func(v T) bool {
+			if !f(v) {
+				return true
+			}
+			return yield(v)
+		})
+	}
+}
+
+// Take return the first n elements of seq
+func Take[T any](seq Seq[T], n int) Seq[T] {
+	return func(yield func(T) bool) {
+		if n <= 0 {
+			return
+		}
+		count := 0
+		seq(func(v T) bool {
+			count++
+			return yield(v) && count < n
+		})
+	}
+}
+
+// Skip skips the first n elements of seq and return the rest
+func Skip[T any](seq Seq[T], n int) Seq[T] {
+	return func(yield func(T) bool) {
+		count := 0
+		seq(func(v T) bool {
+			if count < n {
+				count++
+				return true
+			}
+			return yield(v)
+		})
+	}
+}
+
+// Uniq remove duplicate elements from seq, the first occurrence is kept
+func Uniq[T comparable](seq Seq[T]) Seq[T] {
+	return UniqBy(seq, func(v T) T { return v })
+}
+
+// UniqBy remove duplicate elements from seq by keyFunc, the first occurrence is kept
+func UniqBy[T any, K comparable](seq Seq[T], keyFunc func(T) K) Seq[T] {
+	return func(yield func(T) bool) {
+		seen := make(map[K]struct{})
+		seq(func(v T) bool {
+			k := keyFunc(v)
+			if _, ok := seen[k]; ok {
+				return true
+			}
+			seen[k] = struct{}{}
+			return yield(v)
+		})
+	}
+}
+
+// Chunk divides seq into slices, each containing at most 'size' elements
+//
+// every chunk is a newly allocated slice, so it's safe to keep it after the iteration.
+func Chunk[T any](seq Seq[T], size int) Seq[[]T] {
+	return func(yield func([]T) bool) {
+		if size <= 0 {
+			return
+		}
+		chunk := make([]T, 0, size)
+		stopped := false
+		seq(func(v T) bool {
+			chunk = append(chunk, v)
+			if len(chunk) < size {
+				return true
+			}
+			if !yield(chunk) {
+				stopped = true
+				return false
+			}
+			chunk = make([]T, 0, size)
+			return true
+		})
+		if !stopped && len(chunk) > 0 {
+			yield(chunk)
+		}
+	}
+}
+
+// Flatten flattens a sequence of slices into a single sequence
+func Flatten[T any](seq Seq[[]T]) Seq[T] {
+	return func(yield func(T) bool) {
+		seq(func(slice []T) bool {
+			for _, v := range slice {
+				if !yield(v) {
+					return false
+				}
+			}
+			return true
+		})
+	}
+}
+
+// Zip return a sequence of pairs of elements from a and b,
+// the sequence stops when either a or b is exhausted
+func Zip[A, B any](a Seq[A], b Seq[B]) Seq2[A, B] {
+	return func(yield func(A, B) bool) {
+		next, stop := pull(b)
+		defer stop()
+
+		a(func(va A) bool {
+			vb, ok := next()
+			if !ok {
+				return false
+			}
+			return yield(va, vb)
+		})
+	}
+}
+
+// pull converts the push-style seq into a pull-style next function.
+//
+// seq runs on its own goroutine but only while the caller waits in next or stop, so it never computes ahead.
+// stop must be called once the caller is done, it makes yield return false and waits for seq to return.
+// a panic in seq is re-raised on the caller's goroutine by next or stop.
+func pull[T any](seq Seq[T]) (next func() (T, bool), stop func()) {
+	var (
+		// resume is true to ask seq for the next value, false to make it stop
+		resume   chan bool
+		results  chan pullResult[T]
+		started  bool
+		finished bool
+	)
+
+	start := func() {
+		started = true
+		resume = make(chan bool)
+		results = make(chan pullResult[T])
+		go func() {
+			var last pullResult[T]
+			defer func() {
+				if p := recover(); p != nil {
+					last = pullResult[T]{panicked: true, panicValue: p}
+				}
+				results <- last
+			}()
+
+			if !<-resume {
+				return
+			}
+			seq(func(v T) bool {
+				results <- pullResult[T]{value: v, ok: true}
+				return <-resume
+			})
+		}()
+	}
+
+	next = func() (T, bool) {
+		if finished {
+			var zeroValue T
+			return zeroValue, false
+		}
+		if !started {
+			start()
+		}
+		resume <- true
+		r := <-results
+		if !r.ok {
+			finished = true
+			if r.panicked {
+				panic(r.panicValue)
+			}
+		}
+		return r.value, r.ok
+	}
+	stop = func() {
+		if finished {
+			return
+		}
+		finished = true
+		if !started {
+			return
+		}
+		resume <- false
+		for {
+			r := <-results
+			if r.panicked {
+				panic(r.panicValue)
+			}
+			if !r.ok {
+				return
+			}
+			// seq ignored the false returned by yield, keep asking it to stop
+			resume <- false
+		}
+	}
+	return next, stop
+}
+
+type pullResult[T any] struct {
+	value      T
+	ok         bool
+	panicked   bool
+	panicValue any
+}
@@ -0,0 +1,201 @@
+package giter
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestFromSliceCollect(t *testing.T) {
+	input := []int{1, 2, 3}
+	result := Collect(FromSlice(input))
+	if !reflect.DeepEqual(result, input) {
+		t.Errorf("Expected %v, got %v", input, result)
+	}
+
+	var empty []int
+	result = Collect(FromSlice(empty))
+	if !reflect.DeepEqual(result, []int{}) {
+		t.Errorf("Expected empty slice, got %v", result)
+	}
+}
+
+func TestPipeline(t *testing.T) {
+	input := []int{1, 2, 2, 3, 4, 4, 5, 6, 7, 8}
+	seq := Filter(FromSlice(input), func(v int) bool { return v%2 == 0 })
+	mapped := Map(Uniq(seq), func(v int) int { return v * 10 })
+	result := Collect(Chunk(mapped, 3))
+	expected := [][]int{{20, 40, 60}, {80}}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+}
+
+func TestLaziness(t *testing.T) {
+	calls := 0
+	seq := Map(FromSlice([]int{1, 2, 3, 4, 5}), func(v int) int {
+		calls++
+		return v
+	})
+	result := Collect(Take(seq, 2))
+	if !reflect.DeepEqual(result, []int{1, 2}) {
+		t.Errorf("Expected [1 2], got %v", result)
+	}
+	if calls != 2 {
+		t.Errorf("Expected 2 calls of f, got %d", calls)
+	}
+}
+
+func TestTakeSkip(t *testing.T) {
+	seq := FromSlice([]int{1, 2, 3, 4, 5})
+	tests := []struct {
+		name     string
+		seq      Seq[int]
+		expected []int
+	}{
+		{"take 0", Take(seq, 0), []int{}},
+		{"take 3", Take(seq, 3), []int{1, 2, 3}},
+		{"take more", Take(seq, 10), []int{1, 2, 3, 4, 5}},
+		{"skip 0", Skip(seq, 0), []int{1, 2, 3, 4, 5}},
+		{"skip 3", Skip(seq, 3), []int{4, 5}},
+		{"skip more", Skip(seq, 10), []int{}},
+		{"skip then take", Take(Skip(seq, 1), 2), []int{2, 3}},
+	}
+	for _, tt := range tests {
+		result := Collect(tt.seq)
+		if !reflect.DeepEqual(result, tt.expected) {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.expected, result)
+		}
+	}
+}
+
+func TestUniqBy(t *testing.T) {
+	type person struct {
+		Name string
+		Age  int
+	}
+	input := []person{{"Alice", 30}, {"Bob", 25}, {"Carol", 30}}
+	result := Collect(UniqBy(FromSlice(input), func(p person) int { return p.Age }))
+	expected := []person{{"Alice", 30}, {"Bob", 25}}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+}
+
+func TestChunkEarlyStop(t *testing.T) {
+	result := Collect(Take(Chunk(FromSlice([]int{1, 2, 3, 4, 5}), 2), 1))
+	expected := [][]int{{1, 2}}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+
+	if result := Collect(Chunk(FromSlice([]int{1, 2}), 0)); len(result) != 0 {
+		t.Errorf("Expected empty result, got %v", result)
+	}
+}
+
+func TestFlatten(t *testing.T) {
+	input := [][]int{{1, 2}, {}, {3}}
+	result := Collect(Flatten(FromSlice(input)))
+	expected := []int{1, 2, 3}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+
+	result = Collect(Take(Flatten(FromSlice(input)), 1))
+	if !reflect.DeepEqual(result, []int{1}) {
+		t.Errorf("Expected [1], got %v", result)
+	}
+}
+
+func TestZip(t *testing.T) {
+	a := FromSlice([]int{1, 2, 3})
+	b := FromSlice([]string{"a", "b"})
+
+	var nums []int
+	var strs []string
+	Zip(a, b)(func(n int, s string) bool {
+		nums = append(nums, n)
+		strs = append(strs, s)
+		return true
+	})
+	if !reflect.DeepEqual(nums, []int{1, 2}) || !reflect.DeepEqual(strs, []string{"a", "b"}) {
+		t.Errorf("Zip was incorrect, got %v %v", nums, strs)
+	}
+
+	count := 0
+	Zip(a, FromSlice([]int{4, 5, 6}))(func(int, int) bool {
+		count++
+		return false
+	})
+	if count != 1 {
+		t.Errorf("Expected Zip to stop after 1 pair, got %d", count)
+	}
+}
+
+func TestZipWaitsForProducer(t *testing.T) {
+	produced := 0
+	returned := false
+	b := Seq[int](func(yield func(int) bool) {
+		defer func() { returned = true }()
+		for i := 0; ; i++ {
+			produced++
+			if !yield(i) {
+				return
+			}
+		}
+	})
+
+	Zip(FromSlice([]string{"a", "b"}), b)(func(string, int) bool { return true })
+	if produced != 2 {
+		t.Errorf("Expected b to produce only the 2 values that were paired, got %d", produced)
+	}
+	if !returned {
+		t.Errorf("Expected b to have returned when Zip returns")
+	}
+
+	produced = 0
+	Zip(FromSlice([]string{}), b)(func(string, int) bool { return true })
+	if produced != 0 {
+		t.Errorf("Expected b not to run when a is empty, got %d values", produced)
+	}
+}
+
+func TestZipPanic(t *testing.T) {
+	b := Seq[int](func(yield func(int) bool) {
+		yield(1)
+		panic("boom")
+	})
+
+	defer func() {
+		if r := recover(); r != "boom" {
+			t.Errorf("Expected the panic of b on the caller's goroutine, got %v", r)
+		}
+	}()
+	Zip(FromSlice([]int{1, 2}), b)(func(int, int) bool { return true })
+	t.Errorf("Expected Zip to panic")
+}
+
+func TestMapAdapters(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2}
+	if result := CollectMap(FromMap(m)); !reflect.DeepEqual(result, m) {
+		t.Errorf("Expected %v, got %v", m, result)
+	}
+
+	keys := Collect(Keys(m))
+	sort.Strings(keys)
+	if !reflect.DeepEqual(keys, []string{"a", "b"}) {
+		t.Errorf("Expected [a b], got %v", keys)
+	}
+
+	values := Collect(Values(m))
+	sort.Ints(values)
+	if !reflect.DeepEqual(values, []int{1, 2}) {
+		t.Errorf("Expected [1 2], got %v", values)
+	}
+
+	indexed := CollectMap(Enumerate([]string{"x", "y"}))
+	if !reflect.DeepEqual(indexed, map[int]string{0: "x", 1: "y"}) {
+		t.Errorf("Enumerate was incorrect, got %v", indexed)
+	}
+}
@@ -0,0 +1,177 @@
+package gset
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+
+	"github.com/arcsinw/gg/gmap"
+	"github.com/arcsinw/gg/gslice"
+)
+
+// Set is a set of comparable elements, it's a plain map[T]struct{}
+// so it can also be used with the functions in gmap
+type Set[T comparable] map[T]struct{}
+
+// New return a set containing elems
+func New[T comparable](elems ...T) Set[T] {
+	return FromSlice(elems)
+}
+
+// FromSlice converts a slice into a set
+func FromSlice[T comparable](slice []T) Set[T] {
+	return gslice.ToSet(slice)
+}
+
+// ToSlice return elements of set as a slice (in random sort)
+func (s Set[T]) ToSlice() []T {
+	return gmap.Keys(s)
+}
+
+// Sorted return elements of set as a slice in ascending order
+func Sorted[T gslice.Ordered](s Set[T]) []T {
+	return gslice.Sort(s.ToSlice())
+}
+
+// Add adds elems to the set
+func (s Set[T]) Add(elems ...T) {
+	for _, v := range elems {
+		s[v] = struct{}{}
+	}
+}
+
+// Remove removes elems from the set
+func (s Set[T]) Remove(elems ...T) {
+	for _, v := range elems {
+		delete(s, v)
+	}
+}
+
+// Has return true if v is in the set
+func (s Set[T]) Has(v T) bool {
+	_, ok := s[v]
+	return ok
+}
+
+// Len return the number of elements in the set
+func (s Set[T]) Len() int {
+	return len(s)
+}
+
+// Clone creates a shallow copy of the set
+func (s Set[T]) Clone() Set[T] {
+	return gmap.Clone(s)
+}
+
+// Union return a new set with elements in s or other
+func (s Set[T]) Union(other Set[T]) Set[T] {
+	return gmap.Merge(s, other)
+}
+
+// Intersect return a new set with elements in both s and other
+func (s Set[T]) Intersect(other Set[T]) Set[T] {
+	small, large := s, other
+	if len(small) > len(large) {
+		small, large = large, small
+	}
+
+	result := make(Set[T])
+	for v := range small {
+		if large.Has(v) {
+			result[v] = struct{}{}
+		}
+	}
+	return result
+}
+
+// Difference return a new set with elements in s but not in other
+func (s Set[T]) Difference(other Set[T]) Set[T] {
+	result := make(Set[T])
+	for v := range s {
+		if !other.Has(v) {
+			result[v] = struct{}{}
+		}
+	}
+	return result
+}
+
+// IsSubset return true if every element of s is in other
+func (s Set[T]) IsSubset(other Set[T]) bool {
+	if len(s) > len(other) {
+		return false
+	}
+	for v := range s {
+		if !other.Has(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSuperset return true if every element of other is in s
+func (s Set[T]) IsSuperset(other Set[T]) bool {
+	return other.IsSubset(s)
+}
+
+// Equal return true if s and other contain the same elements
+func (s Set[T]) Equal(other Set[T]) bool {
+	return len(s) == len(other) && s.IsSubset(other)
+}
+
+// MarshalJSON encodes the set as a JSON array
+//
+// the elements are sorted so that the same set always gives the same bytes:
+// by value if T is an integer, float or string type, by their JSON encoding otherwise.
+func (s Set[T]) MarshalJSON() ([]byte, error) {
+	elems := s.ToSlice()
+	if less := orderedLess[T](); less != nil {
+		return json.Marshal(gslice.SortFunc(elems, less))
+	}
+
+	encoded := make([]json.RawMessage, len(elems))
+	for i, v := range elems {
+		data, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		encoded[i] = data
+	}
+	gslice.SortFunc(encoded, func(a, b json.RawMessage) bool { return bytes.Compare(a, b) < 0 })
+	return json.Marshal(encoded)
+}
+
+// orderedLess return a less function comparing values of T by their underlying integer, float or string value,
+// or nil if T has no such underlying type
+func orderedLess[T comparable]() func(T, T) bool {
+	var zeroValue T
+	typ := reflect.TypeOf(zeroValue)
+	if typ == nil {
+		return nil
+	}
+
+	switch typ.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return func(a, b T) bool { return reflect.ValueOf(a).Int() < reflect.ValueOf(b).Int() }
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return func(a, b T) bool { return reflect.ValueOf(a).Uint() < reflect.ValueOf(b).Uint() }
+	case reflect.Float32, reflect.Float64:
+		return func(a, b T) bool { return reflect.ValueOf(a).Float() < reflect.ValueOf(b).Float() }
+	case reflect.String:
+		return func(a, b T) bool { return reflect.ValueOf(a).String() < reflect.ValueOf(b).String() }
+	default:
+		return nil
+	}
+}
+
+// UnmarshalJSON decodes a JSON array into the set, the elements are added to the existing ones
+func (s *Set[T]) UnmarshalJSON(data []byte) error {
+	var elems []T
+	if err := json.Unmarshal(data, &elems); err != nil {
+		return err
+	}
+	if *s == nil {
+		*s = make(Set[T], len(elems))
+	}
+	s.Add(elems...)
+	return nil
+}
@@ -0,0 +1,155 @@
+package gset
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestNew(t *testing.T) {
+	s := New(1, 2, 2, 3)
+	if s.Len() != 3 {
+		t.Errorf("Expected 3 elements, got %v", s)
+	}
+	if !s.Has(2) || s.Has(4) {
+		t.Errorf("Has was incorrect for %v", s)
+	}
+
+	empty := New[int]()
+	if empty.Len() != 0 {
+		t.Errorf("Expected empty set, got %v", empty)
+	}
+}
+
+func TestAddRemove(t *testing.T) {
+	s := New[string]()
+	s.Add("a", "b", "c")
+	s.Remove("b", "d")
+	if expected := []string{"a", "c"}; !reflect.DeepEqual(Sorted(s), expected) {
+		t.Errorf("Expected %v, got %v", expected, Sorted(s))
+	}
+}
+
+func TestToSlice(t *testing.T) {
+	s := FromSlice([]int{3, 1, 2, 3})
+	result := s.ToSlice()
+	sort.Ints(result)
+	if expected := []int{1, 2, 3}; !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+
+	if result := Sorted(New[int]()); !reflect.DeepEqual(result, []int{}) {
+		t.Errorf("Expected empty slice, got %v", result)
+	}
+}
+
+func TestSetAlgebra(t *testing.T) {
+	a := New(1, 2, 3)
+	b := New(2, 3, 4)
+
+	tests := []struct {
+		name     string
+		result   Set[int]
+		expected []int
+	}{
+		{"Union", a.Union(b), []int{1, 2, 3, 4}},
+		{"Intersect", a.Intersect(b), []int{2, 3}},
+		{"Difference", a.Difference(b), []int{1}},
+		{"Difference reversed", b.Difference(a), []int{4}},
+		{"Clone", a.Clone(), []int{1, 2, 3}},
+	}
+	for _, tt := range tests {
+		if result := Sorted(tt.result); !reflect.DeepEqual(result, tt.expected) {
+			t.Errorf("%s was incorrect, got %v, expected %v", tt.name, result, tt.expected)
+		}
+	}
+
+	if !reflect.DeepEqual(Sorted(a), []int{1, 2, 3}) {
+		t.Errorf("set operations should not modify the receiver, got %v", a)
+	}
+}
+
+func TestSubset(t *testing.T) {
+	a := New(1, 2)
+	b := New(1, 2, 3)
+
+	if !a.IsSubset(b) || b.IsSubset(a) {
+		t.Errorf("IsSubset was incorrect")
+	}
+	if !b.IsSuperset(a) || a.IsSuperset(b) {
+		t.Errorf("IsSuperset was incorrect")
+	}
+	if !New[int]().IsSubset(a) {
+		t.Errorf("empty set should be a subset of any set")
+	}
+	if !a.Equal(New(2, 1)) || a.Equal(b) {
+		t.Errorf("Equal was incorrect")
+	}
+}
+
+func TestJSON(t *testing.T) {
+	data, err := json.Marshal(New("a"))
+	if err != nil || string(data) != `["a"]` {
+		t.Errorf("Expected [\"a\"], got %s, %v", data, err)
+	}
+
+	var s Set[int]
+	if err := json.Unmarshal([]byte(`[3, 1, 3]`), &s); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if expected := []int{1, 3}; !reflect.DeepEqual(Sorted(s), expected) {
+		t.Errorf("Expected %v, got %v", expected, Sorted(s))
+	}
+
+	var wrapper struct {
+		Tags Set[string] `json:"tags"`
+	}
+	if err := json.Unmarshal([]byte(`{"tags": ["x", "y"]}`), &wrapper); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !wrapper.Tags.Equal(New("x", "y")) {
+		t.Errorf("Expected [x y], got %v", wrapper.Tags)
+	}
+
+	if err := json.Unmarshal([]byte(`{"a": 1}`), &s); err == nil {
+		t.Errorf("Expected error when decoding an object")
+	}
+}
+
+type userID string
+
+func TestSortedNamedType(t *testing.T) {
+	s := New[userID]("b", "a")
+	if expected := []userID{"a", "b"}; !reflect.DeepEqual(Sorted(s), expected) {
+		t.Errorf("Expected %v, got %v", expected, Sorted(s))
+	}
+}
+
+func TestMarshalJSONDeterministic(t *testing.T) {
+	ints := New(10, 9, -1, 100, 3)
+	for i := 0; i < 10; i++ {
+		data, err := json.Marshal(ints)
+		if err != nil || string(data) != `[-1,3,9,10,100]` {
+			t.Fatalf("Expected [-1,3,9,10,100], got %s, %v", data, err)
+		}
+	}
+
+	ids := New[userID]("b", "c", "a")
+	if data, _ := json.Marshal(ids); string(data) != `["a","b","c"]` {
+		t.Errorf("Expected [\"a\",\"b\",\"c\"], got %s", data)
+	}
+
+	type point struct{ X, Y int }
+	points := New(point{2, 1}, point{1, 2}, point{1, 1})
+	for i := 0; i < 10; i++ {
+		data, err := json.Marshal(points)
+		if expected := `[{"X":1,"Y":1},{"X":1,"Y":2},{"X":2,"Y":1}]`; err != nil || string(data) != expected {
+			t.Fatalf("Expected %s, got %s, %v", expected, data, err)
+		}
+	}
+
+	if data, _ := json.Marshal(New[int]()); string(data) != `[]` {
+		t.Errorf("Expected [], got %s", data)
+	}
+}
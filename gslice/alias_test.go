@@ -0,0 +1,138 @@
+package gslice
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// assertNoAlias checks that the result of f doesn't share memory with its input:
+// writing to every element of the result and appending to it must leave the input,
+// including its spare capacity, unchanged
+//
+// for views (sub-slices documented to share the backing array) only appending is checked.
+func assertNoAlias(t *testing.T, name string, view bool, f func([]int) []int) {
+	t.Helper()
+
+	input := make([]int, 5, 10)
+	for i := range input[:cap(input)] {
+		input[:cap(input)][i] = i + 1
+	}
+	snapshot := append([]int{}, input[:cap(input)]...)
+
+	result := f(input)
+	if !view {
+		for i := range result {
+			result[i] = -1
+		}
+	}
+	_ = append(result, -1, -1, -1, -1, -1, -1)
+
+	assert.Equal(t, snapshot, input[:cap(input)], "%s should not alias its input", name)
+}
+
+func TestNoAlias(t *testing.T) {
+	less := func(a, b int) bool { return a < b }
+	isOdd := func(v int) bool { return v%2 == 1 }
+	r := rand.New(rand.NewSource(1))
+
+	tests := []struct {
+		name string
+		f    func([]int) []int
+	}{
+		{"Map", func(s []int) []int { return Map(s, func(v int) int { return v }) }},
+		{"Filter", func(s []int) []int { return Filter(s, isOdd) }},
+		{"Reverse", Reverse[int]},
+		{"OrderBy", func(s []int) []int { return OrderBy(s, less) }},
+		{"Insert", func(s []int) []int { return Insert(s, 2, 100) }},
+		{"Insert at end", func(s []int) []int { return Insert(s, len(s), 100) }},
+		{"Insert out of range", func(s []int) []int { return Insert(s, -1, 100) }},
+		{"Remove", func(s []int) []int { return Remove(s, 2) }},
+		{"Remove last", func(s []int) []int { return Remove(s, len(s)-1) }},
+		{"Remove out of range", func(s []int) []int { return Remove(s, 10) }},
+		{"Pop", func(s []int) []int { _, rest := Pop(s); return rest }},
+		{"Pop empty", func(s []int) []int { _, rest := Pop(s[:0]); return rest }},
+		{"Append", func(s []int) []int { return Append(s, 100) }},
+		{"Append nothing", func(s []int) []int { return Append(s) }},
+		{"Prepend", func(s []int) []int { return Prepend(s, 100) }},
+		{"Prepend to", func(s []int) []int { return Prepend([]int{100}, s...) }},
+		{"Concat", func(s []int) []int { return Concat(s) }},
+		{"Flatten", func(s []int) []int { return Flatten([][]int{s}) }},
+		{"Uniq", Uniq[int]},
+		{"Uniq empty", func(s []int) []int { return Uniq(s[:0]) }},
+		{"UniqBy", func(s []int) []int { return UniqBy(s, func(v int) int { return v % 2 }) }},
+		{"InsertSorted", func(s []int) []int { return InsertSorted(s, 3) }},
+		{"RemoveSorted", func(s []int) []int { return RemoveSorted(s, 3) }},
+		{"SortedUniq", SortedUniq[int]},
+		{"Intersect", func(s []int) []int { return Intersect(s, s) }},
+		{"Union", func(s []int) []int { return Union(s, nil) }},
+		{"Difference", func(s []int) []int { return Difference(s, nil) }},
+		{"Partition", func(s []int) []int { matched, _ := Partition(s, isOdd); return matched }},
+		{"Shuffle", func(s []int) []int { return Shuffle(s, r) }},
+		{"Sample", func(s []int) []int { return Sample(s, 3, r) }},
+		{"TopK", func(s []int) []int { return TopK(s, 3, less) }},
+		{"PartialSort", func(s []int) []int { return PartialSort(s, 3, less) }},
+	}
+	for _, tt := range tests {
+		assertNoAlias(t, tt.name, false, tt.f)
+	}
+
+	views := []struct {
+		name string
+		f    func([]int) []int
+	}{
+		{"Chunk", func(s []int) []int { return Chunk(s, 2)[0] }},
+		{"Chunk last", func(s []int) []int { c := Chunk(s, 2); return c[len(c)-2] }},
+		{"SlidingWindow", func(s []int) []int { return SlidingWindow(s, 2, 1)[0] }},
+		{"ChunkWhile", func(s []int) []int { return ChunkWhile(s, func(a, b int) bool { return b == a+1 })[0] }},
+		{"PartitionBy", func(s []int) []int { return PartitionBy(s, func(v int) bool { return v < 3 })[0] }},
+		{"SplitAt", func(s []int) []int { left, _ := SplitAt(s, 2); return left }},
+		{"TakeWhile", func(s []int) []int { return TakeWhile(s, func(v int) bool { return v < 3 }) }},
+	}
+	for _, tt := range views {
+		assertNoAlias(t, tt.name, true, tt.f)
+	}
+}
+
+func TestInPlaceVariants(t *testing.T) {
+	input := make([]int, 4, 10)
+	copy(input, []int{1, 2, 4, 5})
+	inserted := InsertInPlace(input, 2, 3)
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, inserted)
+	assert.Equal(t, &input[0], &inserted[0], "InsertInPlace should reuse the backing array")
+
+	assert.Equal(t, []int{1, 2, 3, 4, 5, 6}, InsertInPlace([]int{1, 2, 3}, 3, 4, 5, 6))
+	assert.Equal(t, []int{0, 1}, InsertInPlace([]int{1}, 0, 0))
+	assert.Equal(t, []int{1}, InsertInPlace([]int{1}, 5, 0))
+
+	// elems taken from the slice itself must be read before the shift
+	input = make([]int, 4, 10)
+	copy(input, []int{1, 2, 3, 4})
+	assert.Equal(t, []int{3, 4, 1, 2, 3, 4}, InsertInPlace(input, 0, input[2:4]...))
+	input = make([]int, 4, 10)
+	copy(input, []int{1, 2, 3, 4})
+	assert.Equal(t, []int{1, 1, 2, 2, 3, 4}, InsertInPlace(input, 1, input[:2]...))
+	input = make([]int, 4, 10)
+	copy(input, []int{1, 2, 3, 4})
+	input[:6][5] = 9
+	assert.Equal(t, []int{1, 9, 0, 2, 3, 4}, InsertInPlace(input, 1, input[5:7]...), "elems from the spare capacity")
+	input = []int{1, 2, 3, 4}
+	assert.Equal(t, []int{3, 4, 1, 2, 3, 4}, InsertInPlace(input, 0, input[2:4]...), "without spare capacity")
+
+	input = []int{1, 2, 3, 4}
+	removed := RemoveInPlace(input, 1)
+	assert.Equal(t, []int{1, 3, 4}, removed)
+	assert.Equal(t, []int{1, 3, 4, 0}, input)
+	assert.Equal(t, []int{1, 3, 4}, RemoveInPlace(removed, 3))
+
+	input = []int{1, 2, 3}
+	last, rest := PopInPlace(input)
+	assert.Equal(t, 3, last)
+	assert.Equal(t, []int{1, 2}, rest)
+	assert.Equal(t, []int{1, 2, 0}, input)
+
+	last, rest = PopInPlace([]int{})
+	assert.Equal(t, 0, last)
+	assert.Equal(t, []int{}, rest)
+}
@@ -0,0 +1,73 @@
+package gslice
+
+// CountBy return the number of elements per key
+func CountBy[T any, K comparable](slice []T, keyFunc func(T) K) map[K]int {
+	result := make(map[K]int)
+	for _, v := range slice {
+		result[keyFunc(v)]++
+	}
+	return result
+}
+
+// Frequencies return the number of occurrences of each element
+func Frequencies[T comparable](slice []T) map[T]int {
+	return CountBy(slice, identity[T])
+}
+
+// Mode return the most frequent element of slice,
+// if several elements are equally frequent the one which appears first is returned
+func Mode[T comparable](slice []T) T {
+	return ModeBy(slice, identity[T])
+}
+
+// ModeBy return the most frequent of the values extracted by f, the one which appears first wins on ties
+func ModeBy[T any, K comparable](slice []T, f func(T) K) K {
+	counts := CountBy(slice, f)
+	var mode K
+	best := 0
+	for _, v := range slice {
+		if k := f(v); counts[k] > best {
+			best = counts[k]
+			mode = k
+		}
+	}
+	return mode
+}
+
+// Modes return all the most frequent elements of slice in the order they first appear
+func Modes[T comparable](slice []T) []T {
+	counts := Frequencies(slice)
+	best := 0
+	for _, count := range counts {
+		if count > best {
+			best = count
+		}
+	}
+
+	result := make([]T, 0)
+	for _, v := range slice {
+		if counts[v] == best {
+			result = append(result, v)
+			// only keep the first occurrence
+			counts[v] = 0
+		}
+	}
+	return result
+}
+
+// MostCommon return the n most frequent elements with their counts, from the most frequent,
+// equally frequent elements are ordered by first occurrence. all elements are returned if n exceeds their number
+func MostCommon[T comparable](slice []T, n int) []Entry[T, int] {
+	if n <= 0 {
+		return make([]Entry[T, int], 0)
+	}
+
+	// GroupCount keeps first-seen order and the stable sort preserves it between equal counts
+	counts := SortStableFunc(GroupCount(slice, identity[T]), func(a, b Entry[T, int]) bool {
+		return a.Value > b.Value
+	})
+	if n < len(counts) {
+		counts = counts[:n:n]
+	}
+	return counts
+}
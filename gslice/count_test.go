@@ -0,0 +1,40 @@
+package gslice
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCountBy(t *testing.T) {
+	people := []Person{{"Alice", 30}, {"Bob", 25}, {"Carol", 30}}
+	assert.Equal(t, map[int]int{30: 2, 25: 1}, CountBy(people, func(p Person) int { return p.Age }))
+	assert.Equal(t, map[int]int{}, CountBy([]Person{}, func(p Person) int { return p.Age }))
+
+	assert.Equal(t, map[string]int{"a": 2, "b": 1}, Frequencies([]string{"a", "b", "a"}))
+	assert.Equal(t, map[string]int{}, Frequencies([]string(nil)))
+}
+
+func TestModes(t *testing.T) {
+	assert.Equal(t, "b", Mode([]string{"a", "b", "b", "c"}))
+	assert.Equal(t, "c", Mode([]string{"c", "a", "a", "c"}), "ties are broken by first occurrence")
+	assert.Equal(t, "", Mode([]string{}))
+	assert.Equal(t, Person{"Bob", 25}, Mode([]Person{{"Alice", 30}, {"Bob", 25}, {"Bob", 25}}))
+
+	people := []Person{{"Alice", 30}, {"Bob", 25}, {"Carol", 25}, {"Dave", 30}}
+	assert.Equal(t, 30, ModeBy(people, func(p Person) int { return p.Age }), "ties are broken by first occurrence")
+	assert.Equal(t, 0, ModeBy([]Person{}, func(p Person) int { return p.Age }))
+
+	assert.Equal(t, []string{"c", "a"}, Modes([]string{"c", "a", "b", "a", "c"}))
+	assert.Equal(t, []int{1, 2, 3}, Modes([]int{1, 2, 3}))
+	assert.Equal(t, []int{}, Modes([]int{}))
+}
+
+func TestMostCommon(t *testing.T) {
+	words := []string{"b", "a", "c", "a", "b", "d", "a"}
+	assert.Equal(t, []Entry[string, int]{{"a", 3}, {"b", 2}}, MostCommon(words, 2))
+	assert.Equal(t, []Entry[string, int]{{"a", 3}, {"b", 2}, {"c", 1}, {"d", 1}}, MostCommon(words, 10),
+		"ties are broken by first occurrence")
+	assert.Equal(t, []Entry[string, int]{}, MostCommon(words, 0))
+	assert.Equal(t, []Entry[string, int]{}, MostCommon([]string{}, 3))
+}
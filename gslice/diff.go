@@ -0,0 +1,282 @@
+package gslice
+
+import (
+	"errors"
+)
+
+// EditOp is the kind of an Edit
+type EditOp int
+
+const (
+	// EditEqual keeps the element of the old slice
+	EditEqual EditOp = iota
+	// EditInsert inserts Value
+	EditInsert
+	// EditDelete removes the element of the old slice
+	EditDelete
+)
+
+func (op EditOp) String() string {
+	switch op {
+	case EditEqual:
+		return "equal"
+	case EditInsert:
+		return "insert"
+	case EditDelete:
+		return "delete"
+	default:
+		return "unknown"
+	}
+}
+
+// Edit is a step of an edit script which turns an old slice into a new slice
+//
+// OldIndex and NewIndex are the positions in the old and new slice when the step is applied,
+// Value is the inserted element for EditInsert and the old element otherwise.
+type Edit[T any] struct {
+	Op       EditOp
+	OldIndex int
+	NewIndex int
+	Value    T
+}
+
+// ErrPatchMismatch is returned (wrapped in *IndexError with the index of the edit) by ApplyPatch
+// when the edit script doesn't match the slice
+var ErrPatchMismatch = errors.New("gslice: edit script does not match slice")
+
+// Diff return a minimal edit script (the fewest inserts and deletes) which turns old into new,
+// computed with Myers' algorithm
+//
+// a moved element shows up as a delete and an insert.
+func Diff[T comparable](old, new []T) []Edit[T] {
+	return diff(old, new, func(i, j int) bool {
+		return old[i] == new[j]
+	})
+}
+
+// DiffBy is like Diff but compares elements by the key extracted by keyFunc
+func DiffBy[T any, K comparable](old, new []T, keyFunc func(T) K) []Edit[T] {
+	oldKeys := Map(old, keyFunc)
+	newKeys := Map(new, keyFunc)
+	return diff(old, new, func(i, j int) bool {
+		return oldKeys[i] == newKeys[j]
+	})
+}
+
+// LongestCommonSubsequence return the longest sequence of elements which appear in both a and b in the same order
+func LongestCommonSubsequence[T comparable](a, b []T) []T {
+	result := make([]T, 0)
+	for _, e := range Diff(a, b) {
+		if e.Op == EditEqual {
+			result = append(result, e.Value)
+		}
+	}
+	return result
+}
+
+// ApplyPatch applies the edit script to slice and returns a new slice
+//
+// an error wrapping ErrPatchMismatch is returned if the script was not created for slice.
+func ApplyPatch[T any](slice []T, script []Edit[T]) ([]T, error) {
+	result := make([]T, 0, len(slice))
+	pos := 0
+	for i, e := range script {
+		switch e.Op {
+		case EditInsert:
+			result = append(result, e.Value)
+		case EditEqual, EditDelete:
+			if e.OldIndex != pos || pos >= len(slice) {
+				return nil, &IndexError{Index: i, Err: ErrPatchMismatch}
+			}
+			if e.Op == EditEqual {
+				result = append(result, slice[pos])
+			}
+			pos++
+		default:
+			return nil, &IndexError{Index: i, Err: ErrPatchMismatch}
+		}
+	}
+
+	if pos != len(slice) {
+		return nil, &IndexError{Index: len(script), Err: ErrPatchMismatch}
+	}
+	return result, nil
+}
+
+// diff runs Myers' algorithm on old and new, eq(i, j) reports whether old[i] equals new[j]
+func diff[T any](old, new []T, eq func(i, j int) bool) []Edit[T] {
+	// the common prefix and suffix are always kept, skip them to reduce the search space
+	prefix := 0
+	for prefix < len(old) && prefix < len(new) && eq(prefix, prefix) {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(old)-prefix && suffix < len(new)-prefix && eq(len(old)-1-suffix, len(new)-1-suffix) {
+		suffix++
+	}
+
+	n, m := len(old)-prefix-suffix, len(new)-prefix-suffix
+	middle := myers(n, m, func(i, j int) bool {
+		return eq(prefix+i, prefix+j)
+	})
+
+	result := make([]Edit[T], 0, prefix+len(middle)+suffix)
+	for i := 0; i < prefix; i++ {
+		result = append(result, Edit[T]{Op: EditEqual, OldIndex: i, NewIndex: i, Value: old[i]})
+	}
+	for _, step := range middle {
+		e := Edit[T]{Op: step.Op, OldIndex: prefix + step.OldIndex, NewIndex: prefix + step.NewIndex}
+		if e.Op == EditInsert {
+			e.Value = new[e.NewIndex]
+		} else {
+			e.Value = old[e.OldIndex]
+		}
+		result = append(result, e)
+	}
+	for i := 0; i < suffix; i++ {
+		oi, ni := len(old)-suffix+i, len(new)-suffix+i
+		result = append(result, Edit[T]{Op: EditEqual, OldIndex: oi, NewIndex: ni, Value: old[oi]})
+	}
+	return result
+}
+
+// myers return the edit script between sequences of length n and m without values
+//
+// it's the linear space variant of Myers' algorithm: the middle snake of an optimal path is found
+// by searching from both ends at once, then the parts before and after it are solved recursively,
+// so memory stays O(n+m) however different the sequences are.
+func myers(n, m int, eq func(i, j int) bool) []Edit[struct{}] {
+	s := myersState{eq: eq, result: make([]Edit[struct{}], 0)}
+	s.compare(0, n, 0, m)
+	return s.result
+}
+
+type myersState struct {
+	eq     func(i, j int) bool
+	result []Edit[struct{}]
+}
+
+func (s *myersState) add(op EditOp, oldIndex, newIndex int) {
+	s.result = append(s.result, Edit[struct{}]{Op: op, OldIndex: oldIndex, NewIndex: newIndex})
+}
+
+// compare appends the edit script turning old[aLo:aHi] into new[bLo:bHi]
+func (s *myersState) compare(aLo, aHi, bLo, bHi int) {
+	for aLo < aHi && bLo < bHi && s.eq(aLo, bLo) {
+		s.add(EditEqual, aLo, bLo)
+		aLo++
+		bLo++
+	}
+	suffix := 0
+	for aLo < aHi-suffix && bLo < bHi-suffix && s.eq(aHi-1-suffix, bHi-1-suffix) {
+		suffix++
+	}
+	aEnd, bEnd := aHi-suffix, bHi-suffix
+
+	if aLo < aEnd && bLo < bEnd {
+		if x, y, ok := s.middleSnake(aLo, aEnd, bLo, bEnd); ok {
+			s.compare(aLo, x, bLo, y)
+			s.compare(x, aEnd, y, bEnd)
+			aLo, bLo = aEnd, bEnd
+		}
+	}
+	// nothing in common: delete what's left of old, then insert what's left of new
+	for i := aLo; i < aEnd; i++ {
+		s.add(EditDelete, i, bLo)
+	}
+	for j := bLo; j < bEnd; j++ {
+		s.add(EditInsert, aEnd, j)
+	}
+
+	for i := 0; i < suffix; i++ {
+		s.add(EditEqual, aEnd+i, bEnd+i)
+	}
+}
+
+// middleSnake searches the shortest path from both ends of old[aLo:aHi] and new[bLo:bHi]
+// and return the point where the forward and backward paths overlap,
+// ok is false if the sequences have nothing in common
+func (s *myersState) middleSnake(aLo, aHi, bLo, bHi int) (x, y int, ok bool) {
+	n, m := aHi-aLo, bHi-bLo
+	maxD := (n + m + 1) / 2
+	offset := maxD
+	// forward[offset+k] is the furthest x reached on diagonal k from the start,
+	// backward[offset+k] the furthest distance from the end on diagonal k of the reversed sequences
+	forward := make([]int, 2*maxD+2)
+	backward := make([]int, 2*maxD+2)
+	for i := range forward {
+		forward[i] = -1
+		backward[i] = -1
+	}
+	forward[offset+1] = 0
+	backward[offset+1] = 0
+
+	delta := n - m
+	// if delta is odd the paths overlap while extending the forward one, otherwise the backward one
+	front := delta%2 != 0
+	kStart1, kEnd1, kStart2, kEnd2 := 0, 0, 0, 0
+	for d := 0; d < maxD; d++ {
+		for k1 := -d + kStart1; k1 <= d-kEnd1; k1 += 2 {
+			i := offset + k1
+			var x1 int
+			if k1 == -d || (k1 != d && forward[i-1] < forward[i+1]) {
+				x1 = forward[i+1]
+			} else {
+				x1 = forward[i-1] + 1
+			}
+			y1 := x1 - k1
+			for x1 < n && y1 < m && s.eq(aLo+x1, bLo+y1) {
+				x1++
+				y1++
+			}
+			forward[i] = x1
+
+			switch {
+			case x1 > n:
+				// ran off the right of the grid
+				kEnd1 += 2
+			case y1 > m:
+				// ran off the bottom of the grid
+				kStart1 += 2
+			case front:
+				j := offset + delta - k1
+				if j >= 0 && j < len(backward) && backward[j] != -1 && x1 >= n-backward[j] {
+					return aLo + x1, bLo + y1, true
+				}
+			}
+		}
+
+		for k2 := -d + kStart2; k2 <= d-kEnd2; k2 += 2 {
+			i := offset + k2
+			var x2 int
+			if k2 == -d || (k2 != d && backward[i-1] < backward[i+1]) {
+				x2 = backward[i+1]
+			} else {
+				x2 = backward[i-1] + 1
+			}
+			y2 := x2 - k2
+			for x2 < n && y2 < m && s.eq(aHi-1-x2, bHi-1-y2) {
+				x2++
+				y2++
+			}
+			backward[i] = x2
+
+			switch {
+			case x2 > n:
+				kEnd2 += 2
+			case y2 > m:
+				kStart2 += 2
+			case !front:
+				j := offset + delta - k2
+				if j >= 0 && j < len(forward) && forward[j] != -1 {
+					x1 := forward[j]
+					y1 := x1 - (j - offset)
+					if x1 >= n-x2 {
+						return aLo + x1, bLo + y1, true
+					}
+				}
+			}
+		}
+	}
+	return 0, 0, false
+}
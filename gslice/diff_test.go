@@ -0,0 +1,220 @@
+package gslice
+
+import (
+	"math/rand"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func countEdits[T any](script []Edit[T]) (inserts, deletes int) {
+	for _, e := range script {
+		switch e.Op {
+		case EditInsert:
+			inserts++
+		case EditDelete:
+			deletes++
+		}
+	}
+	return inserts, deletes
+}
+
+// lcsLength computes the length of the longest common subsequence with dynamic programming
+func lcsLength(a, b []int) int {
+	dp := make([][]int, len(a)+1)
+	for i := range dp {
+		dp[i] = make([]int, len(b)+1)
+	}
+	for i := 1; i <= len(a); i++ {
+		for j := 1; j <= len(b); j++ {
+			switch {
+			case a[i-1] == b[j-1]:
+				dp[i][j] = dp[i-1][j-1] + 1
+			case dp[i-1][j] > dp[i][j-1]:
+				dp[i][j] = dp[i-1][j]
+			default:
+				dp[i][j] = dp[i][j-1]
+			}
+		}
+	}
+	return dp[len(a)][len(b)]
+}
+
+func TestDiff(t *testing.T) {
+	old := []string{"a", "b", "c", "a", "b", "b", "a"}
+	new := []string{"c", "b", "a", "b", "a", "c"}
+
+	script := Diff(old, new)
+	inserts, deletes := countEdits(script)
+	assert.Equal(t, 5, inserts+deletes, "the classic example has an edit distance of 5")
+
+	patched, err := ApplyPatch(old, script)
+	assert.NoError(t, err)
+	assert.Equal(t, new, patched)
+}
+
+func TestDiffSimple(t *testing.T) {
+	script := Diff([]int{1, 2, 3}, []int{1, 4, 3})
+	expected := []Edit[int]{
+		{Op: EditEqual, OldIndex: 0, NewIndex: 0, Value: 1},
+		{Op: EditDelete, OldIndex: 1, NewIndex: 1, Value: 2},
+		{Op: EditInsert, OldIndex: 2, NewIndex: 1, Value: 4},
+		{Op: EditEqual, OldIndex: 2, NewIndex: 2, Value: 3},
+	}
+	assert.Equal(t, expected, script)
+}
+
+func TestDiffEdgeCases(t *testing.T) {
+	assert.Equal(t, []Edit[int]{}, Diff([]int{}, []int{}))
+
+	script := Diff([]int{}, []int{1, 2})
+	assert.Equal(t, []Edit[int]{
+		{Op: EditInsert, OldIndex: 0, NewIndex: 0, Value: 1},
+		{Op: EditInsert, OldIndex: 0, NewIndex: 1, Value: 2},
+	}, script)
+
+	script = Diff([]int{1, 2}, []int{})
+	assert.Equal(t, []Edit[int]{
+		{Op: EditDelete, OldIndex: 0, NewIndex: 0, Value: 1},
+		{Op: EditDelete, OldIndex: 1, NewIndex: 0, Value: 2},
+	}, script)
+
+	script = Diff([]int{1, 2}, []int{1, 2})
+	inserts, deletes := countEdits(script)
+	assert.Equal(t, 0, inserts+deletes)
+	assert.Len(t, script, 2)
+}
+
+func TestDiffRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 1000; i++ {
+		old := make([]int, r.Intn(40))
+		for j := range old {
+			old[j] = r.Intn(5)
+		}
+		new := make([]int, r.Intn(40))
+		for j := range new {
+			new[j] = r.Intn(5)
+		}
+
+		script := Diff(old, new)
+		patched, err := ApplyPatch(old, script)
+		assert.NoError(t, err)
+		assert.Equal(t, new, patched, "old: %v", old)
+		assertEditIndexes(t, script)
+
+		inserts, deletes := countEdits(script)
+		lcs := lcsLength(old, new)
+		assert.Equal(t, lcs, len(LongestCommonSubsequence(old, new)))
+		assert.Equal(t, len(old)-lcs, deletes)
+		assert.Equal(t, len(new)-lcs, inserts)
+	}
+}
+
+// assertEditIndexes checks that OldIndex and NewIndex are the positions in old and new reached by the script
+func assertEditIndexes[T any](t *testing.T, script []Edit[T]) {
+	t.Helper()
+	oldPos, newPos := 0, 0
+	for i, e := range script {
+		if e.OldIndex != oldPos || e.NewIndex != newPos {
+			t.Fatalf("edit %d %v has indexes (%d, %d), expected (%d, %d)", i, e.Op, e.OldIndex, e.NewIndex, oldPos, newPos)
+		}
+		if e.Op != EditInsert {
+			oldPos++
+		}
+		if e.Op != EditDelete {
+			newPos++
+		}
+	}
+}
+
+func TestDiffLarge(t *testing.T) {
+	// the elements are unique, so the minimal script deletes exactly the removed ones and inserts the added ones
+	r := rand.New(rand.NewSource(2))
+	old := make([]int, 10000)
+	for i := range old {
+		old[i] = i
+	}
+	new := make([]int, 0, len(old))
+	removed, added := 0, 0
+	for _, v := range old {
+		if r.Intn(10) == 0 {
+			removed++
+			continue
+		}
+		new = append(new, v)
+		if r.Intn(10) == 0 {
+			added++
+			new = append(new, -len(new))
+		}
+	}
+
+	script := Diff(old, new)
+	patched, err := ApplyPatch(old, script)
+	assert.NoError(t, err)
+	assert.Equal(t, new, patched)
+	assertEditIndexes(t, script)
+	inserts, deletes := countEdits(script)
+	assert.Equal(t, removed, deletes)
+	assert.Equal(t, added, inserts)
+}
+
+func TestDiffDisjointMemory(t *testing.T) {
+	old := make([]int, 3000)
+	new := make([]int, 3000)
+	for i := range old {
+		old[i] = i
+		new[i] = -i - 1
+	}
+
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	script := Diff(old, new)
+	runtime.ReadMemStats(&after)
+
+	inserts, deletes := countEdits(script)
+	assert.Equal(t, 3000, inserts)
+	assert.Equal(t, 3000, deletes)
+	// the script itself takes about 6000 * 40 bytes, the search must stay linear in the input
+	assert.Less(t, after.TotalAlloc-before.TotalAlloc, uint64(8<<20))
+}
+
+func TestDiffBy(t *testing.T) {
+	old := []Person{{"Alice", 30}, {"Bob", 25}, {"Carol", 35}}
+	new := []Person{{"Bob", 26}, {"Carol", 35}, {"Dave", 40}}
+
+	script := DiffBy(old, new, func(p Person) string { return p.Name })
+	ops := Map(script, func(e Edit[Person]) EditOp { return e.Op })
+	assert.Equal(t, []EditOp{EditDelete, EditEqual, EditEqual, EditInsert}, ops)
+
+	patched, err := ApplyPatch(old, script)
+	assert.NoError(t, err)
+	assert.Equal(t, []Person{{"Bob", 25}, {"Carol", 35}, {"Dave", 40}}, patched)
+}
+
+func TestLongestCommonSubsequence(t *testing.T) {
+	assert.Equal(t, []byte("GTAB"), LongestCommonSubsequence([]byte("AGGTAB"), []byte("GXTXAYB")))
+	assert.Equal(t, []int{}, LongestCommonSubsequence([]int{1, 2}, []int{3, 4}))
+}
+
+func TestApplyPatchMismatch(t *testing.T) {
+	script := Diff([]int{1, 2, 3}, []int{1, 3})
+
+	_, err := ApplyPatch([]int{1, 2}, script)
+	assert.ErrorIs(t, err, ErrPatchMismatch)
+
+	_, err = ApplyPatch([]int{1, 2, 3, 4}, script)
+	assert.ErrorIs(t, err, ErrPatchMismatch)
+
+	patched, err := ApplyPatch([]int{}, []Edit[int]{{Op: EditInsert, Value: 1}})
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1}, patched)
+}
+
+func TestEditOpString(t *testing.T) {
+	assert.Equal(t, "equal", EditEqual.String())
+	assert.Equal(t, "insert", EditInsert.String())
+	assert.Equal(t, "delete", EditDelete.String())
+	assert.Equal(t, "unknown", EditOp(10).String())
+}
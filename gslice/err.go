@@ -0,0 +1,231 @@
+package gslice
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrMode controls how the Err-suffixed functions handle errors returned by the callback
+type ErrMode int
+
+const (
+	// FailFast stops at the first error and returns it
+	FailFast ErrMode = iota
+	// CollectAll visits every element and returns all errors joined together
+	CollectAll
+)
+
+// IndexError records the index of the element whose callback failed
+type IndexError struct {
+	Index int
+	Err   error
+}
+
+func (e *IndexError) Error() string {
+	return fmt.Sprintf("index %d: %v", e.Index, e.Err)
+}
+
+func (e *IndexError) Unwrap() error {
+	return e.Err
+}
+
+// joinError is the error returned in CollectAll mode, it behaves like the result of errors.Join
+//
+// errors.Is and errors.As only follow Unwrap() []error since Go 1.20,
+// the Is and As methods make them look into every error on older versions too.
+type joinError struct {
+	errs []error
+}
+
+func (e *joinError) Error() string {
+	msgs := make([]string, len(e.errs))
+	for i, err := range e.errs {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "\n")
+}
+
+func (e *joinError) Unwrap() []error {
+	return e.errs
+}
+
+func (e *joinError) Is(target error) bool {
+	for _, err := range e.errs {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+func (e *joinError) As(target any) bool {
+	for _, err := range e.errs {
+		if errors.As(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// errCollector accumulates callback errors according to mode
+type errCollector struct {
+	mode ErrMode
+	errs []error
+}
+
+// add records err for the element at index and reports whether the iteration should stop
+func (c *errCollector) add(index int, err error) bool {
+	if err == nil {
+		return false
+	}
+	c.errs = append(c.errs, &IndexError{Index: index, Err: err})
+	return c.mode == FailFast
+}
+
+func (c *errCollector) err() error {
+	switch len(c.errs) {
+	case 0:
+		return nil
+	case 1:
+		return c.errs[0]
+	default:
+		return &joinError{errs: c.errs}
+	}
+}
+
+// MapErr apply function f to each element of a slice and return a new slice.
+//
+// if any call of f fails, a nil slice and an error wrapping *IndexError are returned.
+func MapErr[T, U any](slice []T, f func(T) (U, error), mode ErrMode) ([]U, error) {
+	if f == nil {
+		return []U{}, nil
+	}
+
+	c := errCollector{mode: mode}
+	result := make([]U, len(slice))
+	for i, v := range slice {
+		u, err := f(v)
+		if c.add(i, err) {
+			break
+		}
+		result[i] = u
+	}
+
+	if err := c.err(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// FilterErr return elements in slice that match the given condition
+//
+// if any call of f fails, a nil slice and an error wrapping *IndexError are returned.
+func FilterErr[T any](slice []T, f func(T) (bool, error), mode ErrMode) ([]T, error) {
+	if f == nil {
+		return []T{}, nil
+	}
+
+	c := errCollector{mode: mode}
+	result := make([]T, 0)
+	for i, v := range slice {
+		ok, err := f(v)
+		if c.add(i, err) {
+			break
+		}
+		if ok && err == nil {
+			result = append(result, v)
+		}
+	}
+
+	if err := c.err(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ForEachErr apply f to each element of slice
+func ForEachErr[T any](slice []T, f func(T) error, mode ErrMode) error {
+	if f == nil {
+		return nil
+	}
+
+	c := errCollector{mode: mode}
+	for i, v := range slice {
+		if c.add(i, f(v)) {
+			break
+		}
+	}
+	return c.err()
+}
+
+// ReduceErr reduce slice to a single value, it stops at the first error
+// since the following steps depend on the failed accumulator
+func ReduceErr[T any](slice []T, f func(T, T) (T, error)) (T, error) {
+	var result T
+	if f == nil {
+		return result, nil
+	}
+
+	for i, v := range slice {
+		next, err := f(result, v)
+		if err != nil {
+			var zeroValue T
+			return zeroValue, &IndexError{Index: i, Err: err}
+		}
+		result = next
+	}
+	return result, nil
+}
+
+// GroupByErr groups the elements in the slice according to the specified key function
+//
+// if any call of keyFunc fails, a nil map and an error wrapping *IndexError are returned.
+func GroupByErr[T any, K comparable](slice []T, keyFunc func(T) (K, error), mode ErrMode) (map[K][]T, error) {
+	result := make(map[K][]T)
+	if keyFunc == nil {
+		return result, nil
+	}
+
+	c := errCollector{mode: mode}
+	for i, item := range slice {
+		key, err := keyFunc(item)
+		if c.add(i, err) {
+			break
+		}
+		if err == nil {
+			result[key] = append(result[key], item)
+		}
+	}
+
+	if err := c.err(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ToMapErr converts a slice into a map using a specified function to extract keys and values.
+//
+// if any call of f fails, a nil map and an error wrapping *IndexError are returned.
+func ToMapErr[T, V any, K comparable](slice []T, f func(T) (K, V, error), mode ErrMode) (map[K]V, error) {
+	result := make(map[K]V)
+	if f == nil {
+		return result, nil
+	}
+
+	c := errCollector{mode: mode}
+	for i, item := range slice {
+		key, value, err := f(item)
+		if c.add(i, err) {
+			break
+		}
+		if err == nil {
+			result[key] = value
+		}
+	}
+
+	if err := c.err(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
@@ -0,0 +1,194 @@
+package gslice
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMapErr(t *testing.T) {
+	input := []string{"1", "2", "3"}
+	result, err := MapErr(input, strconv.Atoi, FailFast)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3}, result)
+
+	result, err = MapErr([]string{}, strconv.Atoi, FailFast)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{}, result)
+}
+
+func TestMapErrFailFast(t *testing.T) {
+	calls := 0
+	result, err := MapErr([]string{"1", "x", "y"}, func(s string) (int, error) {
+		calls++
+		return strconv.Atoi(s)
+	}, FailFast)
+
+	assert.Nil(t, result)
+	assert.Equal(t, 2, calls)
+
+	var indexErr *IndexError
+	if !errors.As(err, &indexErr) {
+		t.Fatalf("Expected *IndexError, got %v", err)
+	}
+	assert.Equal(t, 1, indexErr.Index)
+	assert.ErrorIs(t, err, strconv.ErrSyntax)
+}
+
+func TestMapErrCollectAll(t *testing.T) {
+	result, err := MapErr([]string{"1", "x", "3", "y"}, strconv.Atoi, CollectAll)
+	assert.Nil(t, result)
+
+	joined, ok := err.(interface{ Unwrap() []error })
+	if !ok {
+		t.Fatalf("Expected joined error, got %v", err)
+	}
+	errs := joined.Unwrap()
+	if len(errs) != 2 {
+		t.Fatalf("Expected 2 errors, got %v", errs)
+	}
+	assert.Equal(t, 1, errs[0].(*IndexError).Index)
+	assert.Equal(t, 3, errs[1].(*IndexError).Index)
+	assert.Contains(t, err.Error(), "index 1: ")
+	assert.Contains(t, err.Error(), "index 3: ")
+}
+
+func TestFilterErr(t *testing.T) {
+	isEven := func(s string) (bool, error) {
+		v, err := strconv.Atoi(s)
+		return v%2 == 0, err
+	}
+
+	result, err := FilterErr([]string{"1", "2", "3", "4"}, isEven, FailFast)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"2", "4"}, result)
+
+	result, err = FilterErr([]string{"1", "x", "4"}, isEven, CollectAll)
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, strconv.ErrSyntax)
+}
+
+func TestForEachErr(t *testing.T) {
+	boom := errors.New("boom")
+	var visited []int
+	f := func(v int) error {
+		visited = append(visited, v)
+		if v%2 == 0 {
+			return boom
+		}
+		return nil
+	}
+
+	err := ForEachErr([]int{1, 2, 3, 4}, f, FailFast)
+	assert.ErrorIs(t, err, boom)
+	assert.Equal(t, []int{1, 2}, visited)
+
+	visited = nil
+	err = ForEachErr([]int{1, 2, 3, 4}, f, CollectAll)
+	assert.Error(t, err)
+	assert.Equal(t, []int{1, 2, 3, 4}, visited)
+
+	assert.NoError(t, ForEachErr([]int{1, 3}, f, FailFast))
+}
+
+func TestReduceErr(t *testing.T) {
+	add := func(a, b int) (int, error) {
+		if b < 0 {
+			return 0, errors.New("negative")
+		}
+		return a + b, nil
+	}
+
+	result, err := ReduceErr([]int{1, 2, 3, 4}, add)
+	assert.NoError(t, err)
+	assert.Equal(t, 10, result)
+
+	result, err = ReduceErr([]int{1, -2, 3}, add)
+	assert.Equal(t, 0, result)
+	var indexErr *IndexError
+	if !errors.As(err, &indexErr) || indexErr.Index != 1 {
+		t.Errorf("Expected *IndexError at index 1, got %v", err)
+	}
+}
+
+func TestGroupByErr(t *testing.T) {
+	keyFunc := func(s string) (int, error) {
+		v, err := strconv.Atoi(s)
+		return v % 2, err
+	}
+
+	result, err := GroupByErr([]string{"1", "2", "3"}, keyFunc, FailFast)
+	assert.NoError(t, err)
+	expected := map[int][]string{1: {"1", "3"}, 0: {"2"}}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+
+	result, err = GroupByErr([]string{"1", "x"}, keyFunc, FailFast)
+	assert.Nil(t, result)
+	assert.Error(t, err)
+}
+
+func TestToMapErr(t *testing.T) {
+	f := func(s string) (string, int, error) {
+		v, err := strconv.Atoi(s)
+		return s, v, err
+	}
+
+	result, err := ToMapErr([]string{"1", "2"}, f, CollectAll)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]int{"1": 1, "2": 2}, result)
+
+	result, err = ToMapErr([]string{"1", "x", "y"}, f, CollectAll)
+	assert.Nil(t, result)
+	assert.Len(t, err.(interface{ Unwrap() []error }).Unwrap(), 2)
+}
+
+func TestJoinErrorIsAs(t *testing.T) {
+	_, err := MapErr([]string{"x", "1", "y"}, strconv.Atoi, CollectAll)
+
+	// call the methods directly, errors.Is only follows Unwrap() []error since Go 1.20
+	joined, ok := err.(interface {
+		Is(error) bool
+		As(any) bool
+	})
+	if !ok {
+		t.Fatalf("Expected the joined error to implement Is and As, got %T", err)
+	}
+	assert.True(t, joined.Is(strconv.ErrSyntax))
+	assert.False(t, joined.Is(errors.New("other")))
+
+	var indexErr *IndexError
+	assert.True(t, joined.As(&indexErr))
+	assert.Equal(t, 0, indexErr.Index)
+
+	var numErr *strconv.NumError
+	assert.True(t, errors.As(err, &numErr))
+}
+
+func TestErrNilCallback(t *testing.T) {
+	mapped, err := MapErr[int, int]([]int{1}, nil, FailFast)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{}, mapped)
+
+	filtered, err := FilterErr([]int{1}, nil, FailFast)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{}, filtered)
+
+	assert.NoError(t, ForEachErr([]int{1}, nil, FailFast))
+
+	reduced, err := ReduceErr([]int{1}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, reduced)
+
+	groups, err := GroupByErr[int, int]([]int{1}, nil, FailFast)
+	assert.NoError(t, err)
+	assert.Equal(t, map[int][]int{}, groups)
+
+	m, err := ToMapErr[int, int, int]([]int{1}, nil, FailFast)
+	assert.NoError(t, err)
+	assert.Equal(t, map[int]int{}, m)
+}
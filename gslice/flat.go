@@ -0,0 +1,86 @@
+package gslice
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// FlatMap maps each element of slice to a slice and concatenates the results,
+// it's the same as Flatten(Map(slice, f)) without the intermediate [][]U
+func FlatMap[T, U any](slice []T, f func(T) []U) []U {
+	return FlatMapIndexed(slice, func(_ int, v T) []U { return f(v) })
+}
+
+// FlatMapIndexed is like FlatMap but f also receives the index of the element
+func FlatMapIndexed[T, U any](slice []T, f func(int, T) []U) []U {
+	result := make([]U, 0, len(slice))
+	for i, v := range slice {
+		result = append(result, f(i, v)...)
+	}
+	return result
+}
+
+// FlattenDeep flattens slices and arrays nested to any depth into a slice of T,
+// e.g. FlattenDeep[int]([]any{1, []int{2, 3}, [][]int{{4}}}) => [1, 2, 3, 4]
+//
+// a value is appended as soon as it's assignable to T, so FlattenDeep[[]int] stops at []int,
+// except if T is an interface type: slices and arrays are always flattened then, e.g. FlattenDeep[any] on ragged []any.
+// an error is returned if a value which is neither a T nor a slice or array is found.
+func FlattenDeep[T any](nested any) ([]T, error) {
+	result := make([]T, 0)
+	if nested == nil {
+		return result, nil
+	}
+
+	target := reflect.TypeOf((*T)(nil)).Elem()
+	var walk func(v reflect.Value) error
+	walk = func(v reflect.Value) error {
+		for v.Kind() == reflect.Interface && !v.IsNil() {
+			v = v.Elem()
+		}
+		nested := v.Kind() == reflect.Slice || v.Kind() == reflect.Array
+		// every slice is assignable to an interface type, so look inside it first
+		if v.IsValid() && v.Type().AssignableTo(target) && !(nested && target.Kind() == reflect.Interface) {
+			// the assertion only fails for a nil interface, which leaves the zero value of T
+			item, _ := v.Interface().(T)
+			result = append(result, item)
+			return nil
+		}
+		if !v.IsValid() || v.Kind() == reflect.Interface {
+			return fmt.Errorf("gslice: cannot flatten nil into %s", target)
+		}
+		if !nested {
+			return fmt.Errorf("gslice: cannot flatten %s into %s", v.Type(), target)
+		}
+		for i := 0; i < v.Len(); i++ {
+			if err := walk(v.Index(i)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if err := walk(reflect.ValueOf(nested)); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// FlattenDeepFunc flattens a tree given its roots and a children function in pre-order:
+// each node is followed by its descendants, depth first
+func FlattenDeepFunc[T any](roots []T, children func(T) []T) []T {
+	result := make([]T, 0, len(roots))
+	// the stack holds the nodes still to visit, the next one on top
+	stack := Reverse(roots)
+	for len(stack) > 0 {
+		node := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		result = append(result, node)
+
+		kids := children(node)
+		for i := len(kids) - 1; i >= 0; i-- {
+			stack = append(stack, kids[i])
+		}
+	}
+	return result
+}
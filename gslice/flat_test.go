@@ -0,0 +1,70 @@
+package gslice
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlatMap(t *testing.T) {
+	words := FlatMap([]string{"a b", "c", ""}, strings.Fields)
+	assert.Equal(t, []string{"a", "b", "c"}, words)
+	assert.Equal(t, []string{}, FlatMap([]string{}, strings.Fields))
+
+	repeated := FlatMapIndexed([]string{"a", "b", "c"}, func(i int, s string) []string {
+		return strings.Split(strings.Repeat(s, i), "")
+	})
+	assert.Equal(t, []string{"b", "c", "c"}, repeated)
+}
+
+func TestFlattenDeep(t *testing.T) {
+	result, err := FlattenDeep[int]([]any{1, []int{2, 3}, [][]int{{4}, {}}, [2]int{5, 6}, []any{[]any{7}}})
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3, 4, 5, 6, 7}, result)
+
+	ragged, err := FlattenDeep[[]int]([][][]int{{{1}, {2, 3}}, {}, {{4}}})
+	assert.NoError(t, err)
+	assert.Equal(t, [][]int{{1}, {2, 3}, {4}}, ragged, "flattening should stop at values assignable to T")
+
+	anys, err := FlattenDeep[any]([]any{1, []any{"2", []any{3.0}}, [][]int{{4}}, nil})
+	assert.NoError(t, err)
+	assert.Equal(t, []any{1, "2", 3.0, 4, nil}, anys)
+
+	stringers, err := FlattenDeep[fmt.Stringer]([]any{[]time.Duration{time.Second}, []any{time.Minute}})
+	assert.NoError(t, err)
+	assert.Equal(t, []fmt.Stringer{time.Second, time.Minute}, stringers)
+
+	_, err = FlattenDeep[fmt.Stringer]([]any{1})
+	assert.EqualError(t, err, "gslice: cannot flatten int into fmt.Stringer")
+
+	result, err = FlattenDeep[int](nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{}, result)
+
+	result, err = FlattenDeep[int]([]any{1, "2"})
+	assert.Nil(t, result)
+	assert.EqualError(t, err, "gslice: cannot flatten string into int")
+
+	_, err = FlattenDeep[int]([]any{1, nil})
+	assert.EqualError(t, err, "gslice: cannot flatten nil into int")
+}
+
+func TestFlattenDeepFunc(t *testing.T) {
+	type node struct {
+		name     string
+		children []node
+	}
+	tree := []node{
+		{"a", []node{{"b", []node{{"c", nil}}}, {"d", nil}}},
+		{"e", nil},
+	}
+
+	result := FlattenDeepFunc(tree, func(n node) []node { return n.children })
+	names := Map(result, func(n node) string { return n.name })
+	assert.Equal(t, []string{"a", "b", "c", "d", "e"}, names)
+
+	assert.Equal(t, []node{}, FlattenDeepFunc([]node{}, func(n node) []node { return n.children }))
+}
@@ -0,0 +1,46 @@
+package gslice
+
+// Fold combines the elements of slice from left to right into an accumulator of any type, starting from init,
+// e.g. Fold([]int{1, 2, 3}, "", f) computes f(f(f("", 1), 2), 3)
+func Fold[T any, A any](slice []T, init A, f func(A, T) A) A {
+	result := init
+	for _, v := range slice {
+		result = f(result, v)
+	}
+	return result
+}
+
+// FoldRight is like Fold but combines the elements from right to left,
+// e.g. FoldRight([]int{1, 2, 3}, "", f) computes f(f(f("", 3), 2), 1)
+func FoldRight[T any, A any](slice []T, init A, f func(A, T) A) A {
+	result := init
+	for i := len(slice) - 1; i >= 0; i-- {
+		result = f(result, slice[i])
+	}
+	return result
+}
+
+// ReduceWithInit is like Reduce but starts from init instead of the zero value of T
+func ReduceWithInit[T any](slice []T, init T, f func(T, T) T) T {
+	return Fold(slice, init, f)
+}
+
+// Scan return the intermediate accumulators of Fold, one per element,
+// e.g. Scan([]int{1, 2, 3}, 0, add) => [1, 3, 6]
+func Scan[T any, A any](slice []T, init A, f func(A, T) A) []A {
+	result := make([]A, 0, len(slice))
+	acc := init
+	for _, v := range slice {
+		acc = f(acc, v)
+		result = append(result, acc)
+	}
+	return result
+}
+
+// ScanLeft is like Scan but the result starts with init,
+// e.g. ScanLeft([]int{1, 2, 3}, 0, add) => [0, 1, 3, 6]
+func ScanLeft[T any, A any](slice []T, init A, f func(A, T) A) []A {
+	result := make([]A, 0, len(slice)+1)
+	result = append(result, init)
+	return append(result, Scan(slice, init, f)...)
+}
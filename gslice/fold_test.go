@@ -0,0 +1,46 @@
+package gslice
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFold(t *testing.T) {
+	type summary struct {
+		Count int
+		Total int
+	}
+	people := []Person{{"Alice", 30}, {"Bob", 25}}
+	result := Fold(people, summary{}, func(s summary, p Person) summary {
+		return summary{Count: s.Count + 1, Total: s.Total + p.Age}
+	})
+	assert.Equal(t, summary{Count: 2, Total: 55}, result)
+
+	concat := func(acc string, v int) string { return acc + strconv.Itoa(v) }
+	assert.Equal(t, ">123", Fold([]int{1, 2, 3}, ">", concat))
+	assert.Equal(t, ">321", FoldRight([]int{1, 2, 3}, ">", concat))
+	assert.Equal(t, ">", Fold([]int{}, ">", concat))
+	assert.Equal(t, ">", FoldRight(nil, ">", concat))
+}
+
+func TestReduceWithInit(t *testing.T) {
+	mul := func(a, b int) int { return a * b }
+	assert.Equal(t, 24, ReduceWithInit([]int{2, 3, 4}, 1, mul))
+	assert.Equal(t, 1, ReduceWithInit([]int{}, 1, mul))
+	// Reduce starts from the zero value
+	assert.Equal(t, 0, Reduce([]int{2, 3, 4}, mul))
+}
+
+func TestScan(t *testing.T) {
+	add := func(a, b int) int { return a + b }
+	assert.Equal(t, []int{1, 3, 6}, Scan([]int{1, 2, 3}, 0, add))
+	assert.Equal(t, []int{0, 1, 3, 6}, ScanLeft([]int{1, 2, 3}, 0, add))
+
+	assert.Equal(t, []int{}, Scan([]int{}, 10, add))
+	assert.Equal(t, []int{10}, ScanLeft(nil, 10, add))
+
+	lengths := Scan([]string{"a", "bc"}, 0, func(n int, s string) int { return n + len(s) })
+	assert.Equal(t, []int{1, 3}, lengths)
+}
@@ -0,0 +1,85 @@
+package gslice
+
+// Entry is a key-value pair, used where the order of keys matters and a map can't be returned
+type Entry[K any, V any] struct {
+	Key   K
+	Value V
+}
+
+// GroupByOrdered is like GroupBy but returns the groups in the order their keys are first seen
+func GroupByOrdered[T any, K comparable](slice []T, keyFunc func(T) K) []Entry[K, []T] {
+	return GroupAggregate(slice, keyFunc, func() []T { return nil }, func(group []T, v T) []T {
+		return append(group, v)
+	})
+}
+
+// GroupAggregate groups the elements by keyFunc and folds each group into a value starting from init(),
+// the groups are returned in the order their keys are first seen
+//
+// init is called once per group, so accumulators such as slices, maps or pointers are never shared between groups.
+// no intermediate []T is built for the groups, e.g. counting the elements per key:
+// GroupAggregate(slice, keyFunc, func() int { return 0 }, func(n int, _ T) int { return n + 1 })
+func GroupAggregate[T any, K comparable, A any](slice []T, keyFunc func(T) K, init func() A, fold func(A, T) A) []Entry[K, A] {
+	result := make([]Entry[K, A], 0)
+	if keyFunc == nil {
+		return result
+	}
+
+	index := make(map[K]int)
+	for _, v := range slice {
+		k := keyFunc(v)
+		i, ok := index[k]
+		if !ok {
+			i = len(result)
+			index[k] = i
+			result = append(result, Entry[K, A]{Key: k, Value: init()})
+		}
+		result[i].Value = fold(result[i].Value, v)
+	}
+	return result
+}
+
+// GroupCount return the number of elements per key, in the order the keys are first seen
+func GroupCount[T any, K comparable](slice []T, keyFunc func(T) K) []Entry[K, int] {
+	return GroupAggregate(slice, keyFunc, zero[int], func(n int, _ T) int { return n + 1 })
+}
+
+// GroupSum return the sum of f over the elements per key, in the order the keys are first seen
+func GroupSum[T any, K comparable, E Number](slice []T, keyFunc func(T) K, f func(T) E) []Entry[K, E] {
+	return GroupAggregate(slice, keyFunc, zero[E], func(sum E, v T) E { return sum + f(v) })
+}
+
+// GroupMin return the min element per key by less function, the first one wins on ties
+func GroupMin[T any, K comparable](slice []T, keyFunc func(T) K, less func(T, T) bool) []Entry[K, T] {
+	return groupBest(slice, keyFunc, less)
+}
+
+// GroupMax return the max element per key by less function, the first one wins on ties
+func GroupMax[T any, K comparable](slice []T, keyFunc func(T) K, less func(T, T) bool) []Entry[K, T] {
+	return groupBest(slice, keyFunc, func(a, b T) bool { return less(b, a) })
+}
+
+// groupBest keeps per key the first element that no later element is before
+func groupBest[T any, K comparable](slice []T, keyFunc func(T) K, before func(T, T) bool) []Entry[K, T] {
+	type best struct {
+		value T
+		set   bool
+	}
+	groups := GroupAggregate(slice, keyFunc, zero[best], func(b best, v T) best {
+		if !b.set || before(v, b.value) {
+			return best{value: v, set: true}
+		}
+		return b
+	})
+
+	result := make([]Entry[K, T], len(groups))
+	for i, g := range groups {
+		result[i] = Entry[K, T]{Key: g.Key, Value: g.Value.value}
+	}
+	return result
+}
+
+func zero[T any]() T {
+	var zeroValue T
+	return zeroValue
+}
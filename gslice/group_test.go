@@ -0,0 +1,74 @@
+package gslice
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type order struct {
+	Customer string
+	Amount   int
+}
+
+var orders = []order{
+	{"carol", 30},
+	{"alice", 10},
+	{"carol", 5},
+	{"bob", 20},
+	{"alice", 10},
+}
+
+func byCustomer(o order) string { return o.Customer }
+
+func TestGroupByOrdered(t *testing.T) {
+	expected := []Entry[string, []order]{
+		{"carol", []order{{"carol", 30}, {"carol", 5}}},
+		{"alice", []order{{"alice", 10}, {"alice", 10}}},
+		{"bob", []order{{"bob", 20}}},
+	}
+	assert.Equal(t, expected, GroupByOrdered(orders, byCustomer))
+
+	assert.Equal(t, []Entry[string, []order]{}, GroupByOrdered([]order{}, byCustomer))
+	assert.Equal(t, []Entry[string, []order]{}, GroupByOrdered[order, string](orders, nil))
+}
+
+func TestGroupAggregate(t *testing.T) {
+	result := GroupAggregate(orders, byCustomer, func() string { return "" }, func(acc string, o order) string {
+		return acc + "+"
+	})
+	assert.Equal(t, []Entry[string, string]{{"carol", "++"}, {"alice", "++"}, {"bob", "+"}}, result)
+
+	// every group gets its own accumulator, even one with spare capacity or a map
+	parity := func(v int) int { return v % 2 }
+	lists := GroupAggregate([]int{1, 2, 3, 4}, parity, func() []int { return make([]int, 0, 10) }, func(acc []int, v int) []int {
+		return append(acc, v)
+	})
+	assert.Equal(t, []Entry[int, []int]{{1, []int{1, 3}}, {0, []int{2, 4}}}, lists)
+
+	sets := GroupAggregate([]int{1, 2, 3, 4}, parity, func() map[int]bool { return map[int]bool{} }, func(acc map[int]bool, v int) map[int]bool {
+		acc[v] = true
+		return acc
+	})
+	assert.Equal(t, []Entry[int, map[int]bool]{{1, map[int]bool{1: true, 3: true}}, {0, map[int]bool{2: true, 4: true}}}, sets)
+
+	assert.Equal(t, []Entry[string, int]{{"carol", 2}, {"alice", 2}, {"bob", 1}}, GroupCount(orders, byCustomer))
+	assert.Equal(t, []Entry[string, int]{{"carol", 35}, {"alice", 20}, {"bob", 20}},
+		GroupSum(orders, byCustomer, func(o order) int { return o.Amount }))
+}
+
+func TestGroupMinMax(t *testing.T) {
+	byAmount := func(a, b order) bool { return a.Amount < b.Amount }
+	assert.Equal(t, []Entry[string, order]{
+		{"carol", order{"carol", 5}},
+		{"alice", order{"alice", 10}},
+		{"bob", order{"bob", 20}},
+	}, GroupMin(orders, byCustomer, byAmount))
+
+	people := []Person{{"Alice", 30}, {"Bob", 30}, {"Carol", 25}}
+	byAge := func(a, b Person) bool { return a.Age < b.Age }
+	all := func(Person) bool { return true }
+	assert.Equal(t, []Entry[bool, Person]{{true, Person{"Alice", 30}}}, GroupMax(people, all, byAge), "the first max should win")
+	assert.Equal(t, []Entry[bool, Person]{{true, Person{"Carol", 25}}}, GroupMin(people, all, byAge))
+	assert.Equal(t, []Entry[bool, Person]{}, GroupMax([]Person{}, all, byAge))
+}
@@ -1,17 +1,20 @@
 package gslice
 
 import (
-	"sort"
+	"unsafe"
 )
 
 //TIP using empty slice as default value of slice, not nil
 
+// Number is a constraint that permits any integer or floating-point type,
+// including named types such as `type Cents int64`
 type Number interface {
-	int | int8 | int16 | int32 | int64 |
-		uint | uint8 | uint16 | uint32 | uint64 |
-		float32 | float64
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
 }
 
+// Ordered is a constraint that permits any type supporting the < operator
 type Ordered interface {
 	Number | ~string
 }
@@ -28,63 +31,166 @@ func IsNegativeFunc[T Number](t T) bool {
 	return t < 0
 }
 
-// Sort sort the elements in the slice in place
+// Sort sort the elements in the slice in place, NaNs are placed first
+//
+// large slices of integers and short strings are sorted with radix sort, others with pdqsort.
 func Sort[T Ordered](slice []T) []T {
-	sort.SliceStable(slice, func(i, j int) bool {
-		return slice[i] < slice[j]
-	})
+	if !radixSort(slice) {
+		SortFunc(slice, lessNaN[T])
+	}
 	return slice
 }
 
 // Chunk divides the slice into smaller slices, each containing at most 'size' elements
+//
+// the chunks share the backing array of slice and are capped at their length,
+// so appending to a chunk never overwrites the next chunk.
 func Chunk[T any](slice []T, size int) [][]T {
 	if size <= 0 {
 		return [][]T{}
 	}
 	result := make([][]T, 0)
-	for i := 0; i < len(slice); i += size {
-		end := i + size
-		if end > len(slice) {
-			end = len(slice)
-		}
-		result = append(result, slice[i:end])
+	for i := 0; i < chunkCount(len(slice), size); i++ {
+		result = append(result, chunkAt(slice, size, i))
 	}
 	return result
 }
 
+// chunkCount return the number of chunks of 'size' elements needed for n elements, size must be positive
+func chunkCount(n, size int) int {
+	// n + size - 1 would overflow for a size close to math.MaxInt
+	count := n / size
+	if n%size != 0 {
+		count++
+	}
+	return count
+}
+
+// chunkAt return the i-th chunk of Chunk(slice, size) without building the others,
+// i must be in [0, chunkCount(len(slice), size))
+func chunkAt[T any](slice []T, size, i int) []T {
+	start := i * size
+	end := len(slice)
+	if size < end-start {
+		end = start + size
+	}
+	return slice[start:end:end]
+}
+
 // Pop removes and returns the last element from the slice and new slice
+//
+// slice is not modified, use PopInPlace to avoid copying.
 func Pop[T any](slice []T) (T, []T) {
+	if len(slice) == 0 {
+		var zeroValue T
+		return zeroValue, slice[:0:0]
+	}
+	return slice[len(slice)-1], clone(slice[:len(slice)-1])
+}
+
+// PopInPlace removes and returns the last element from the slice and the shortened slice,
+// which shares the backing array of slice
+func PopInPlace[T any](slice []T) (T, []T) {
 	if len(slice) == 0 {
 		var zeroValue T
 		return zeroValue, slice
 	}
-	return slice[len(slice)-1], slice[:len(slice)-1]
+	last := slice[len(slice)-1]
+	return last, truncate(slice, len(slice)-1)
 }
 
 // Append appends elements to the end of a slice and returns a new slice
+//
+// unlike the builtin append, the spare capacity of slice is never written.
 func Append[T any](slice []T, elems ...T) []T {
-	return append(slice, elems...)
+	result := make([]T, 0, len(slice)+len(elems))
+	result = append(result, slice...)
+	return append(result, elems...)
 }
 
 // Prepend append one or more items to the beginning of slice and returns a new slice
 func Prepend[T any](slice []T, elems ...T) []T {
-	return append(elems, slice...)
+	return Append(elems, slice...)
 }
 
 // Insert inserts elements into a slice at a specified index and returns a new slice
+//
+// if index is out of range, a copy of slice is returned.
 func Insert[T any](slice []T, index int, elems ...T) []T {
+	if index < 0 || index > len(slice) {
+		return clone(slice)
+	}
+	result := make([]T, 0, len(slice)+len(elems))
+	result = append(result, slice[:index]...)
+	result = append(result, elems...)
+	return append(result, slice[index:]...)
+}
+
+// InsertInPlace inserts elements into a slice at a specified index,
+// the backing array of slice is reused when it has enough capacity
+//
+// if index is out of range, slice is returned unchanged.
+func InsertInPlace[T any](slice []T, index int, elems ...T) []T {
 	if index < 0 || index > len(slice) {
 		return slice
 	}
-	return append(slice[:index], append(elems, slice[index:]...)...)
+	n := len(slice)
+	// the shift would overwrite elems before they are copied if they share the backing array of slice
+	if n+len(elems) <= cap(slice) && overlaps(slice[:cap(slice)], elems) {
+		elems = clone(elems)
+	}
+	slice = append(slice, elems...)
+	copy(slice[index+len(elems):], slice[index:n])
+	copy(slice[index:], elems)
+	return slice
 }
 
-// Remove remove an element from a slice at a given index
+// Remove remove an element from a slice at a given index and returns a new slice
+//
+// if index is out of range, a copy of slice is returned.
 func Remove[T any](slice []T, index int) []T {
+	if index < 0 || index >= len(slice) {
+		return clone(slice)
+	}
+	result := make([]T, 0, len(slice)-1)
+	result = append(result, slice[:index]...)
+	return append(result, slice[index+1:]...)
+}
+
+// RemoveInPlace remove an element from a slice at a given index,
+// the following elements are shifted left in the backing array of slice and the freed slot is zeroed
+//
+// if index is out of range, slice is returned unchanged.
+func RemoveInPlace[T any](slice []T, index int) []T {
 	if index < 0 || index >= len(slice) {
 		return slice
 	}
-	return append(slice[:index], slice[index+1:]...)
+	copy(slice[index:], slice[index+1:])
+	return truncate(slice, len(slice)-1)
+}
+
+// clone returns a copy of slice, nil stays nil
+func clone[T any](slice []T) []T {
+	if slice == nil {
+		return nil
+	}
+	result := make([]T, len(slice))
+	copy(result, slice)
+	return result
+}
+
+// overlaps reports whether a and b share any element of their backing arrays
+func overlaps[T any](a, b []T) bool {
+	if len(a) == 0 || len(b) == 0 {
+		return false
+	}
+	size := unsafe.Sizeof(a[0])
+	if size == 0 {
+		return false
+	}
+	aStart, bStart := uintptr(unsafe.Pointer(&a[0])), uintptr(unsafe.Pointer(&b[0]))
+	aEnd, bEnd := aStart+uintptr(len(a))*size, bStart+uintptr(len(b))*size
+	return aStart < bEnd && bStart < aEnd
 }
 
 // Map apply function f to each element of a slice and return a new slice
@@ -188,14 +294,11 @@ func AnyMatch[T any](slice []T, condition func(T) bool) bool {
 	return false
 }
 
-// OrderBy return a new slice sorted by less function
+// OrderBy return a new slice sorted by less function, the order of equal elements is preserved
 func OrderBy[T any](slice []T, less func(T, T) bool) []T {
 	result := make([]T, len(slice))
 	copy(result, slice)
-	sort.SliceStable(result, func(i, j int) bool {
-		return less(result[i], result[j])
-	})
-	return result
+	return SortStableFunc(result, less)
 }
 
 // Min return the min value of slice
@@ -259,48 +362,24 @@ func Flatten[T any](slices [][]T) []T {
 }
 
 // Uniq remove duplicate elements from slice
+//
+// it returns a new slice, use UniqInPlace to reuse the backing array of slice.
 func Uniq[T comparable](slice []T) []T {
-	if len(slice) == 0 {
-		return slice
-	}
-
-	result := make([]T, 0)
-
-	m := map[T]interface{}{}
-	for _, elem := range slice {
-		k := elem
-		if _, ok := m[k]; ok {
-			continue
-		} else {
-			m[k] = elem
-		}
-
-		result = append(result, elem)
-	}
-
-	return result
+	return UniqBy(slice, func(v T) T { return v })
 }
 
 // UniqBy remove duplicate elements from slice by keyFunc
+//
+// it returns a new slice, use UniqByInPlace to reuse the backing array of slice.
 func UniqBy[T any, K comparable](slice []T, keyFunc func(T) K) []T {
 	if len(slice) == 0 {
-		return slice
+		return slice[:0:0]
 	}
 
 	result := make([]T, 0)
-
-	m := map[K]interface{}{}
-	for _, elem := range slice {
-		k := keyFunc(elem)
-		if _, ok := m[k]; ok {
-			continue
-		} else {
-			m[k] = elem
-		}
-
-		result = append(result, elem)
-	}
-
+	uniqBy(slice, keyFunc, func(v T) {
+		result = append(result, v)
+	})
 	return result
 }
 
@@ -324,6 +403,8 @@ func Contains[T comparable](s []T, v T) bool {
 }
 
 // Filter return elements in slice that match the given condition
+//
+// it returns a new slice, use FilterInPlace to reuse the backing array of slice.
 func Filter[T any](slice []T, f func(T) bool) []T {
 	result := make([]T, 0)
 	for _, v := range slice {
@@ -362,6 +443,8 @@ func GroupBy[T any, K comparable](slice []T, keyFunc func(T) K) map[K][]T {
 }
 
 // ToMap converts a slice into a map using a specified function to extract keys and values.
+//
+// the last element wins on duplicate keys, see ToMapStrict, ToMapKeepFirst and ToMapMerge for other policies.
 func ToMap[T, V any, K comparable](slice []T, f func(T) (K, V)) map[K]V {
 	result := make(map[K]V)
 	for _, item := range slice {
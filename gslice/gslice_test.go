@@ -2,6 +2,7 @@ package gslice
 
 import (
 	"github.com/stretchr/testify/assert"
+	"math"
 	"reflect"
 	"testing"
 )
@@ -790,6 +791,17 @@ func TestChunkWithEmptySlice(t *testing.T) {
 	}
 }
 
+// TestChunkLargeSize 测试 size 接近 math.MaxInt 时不会溢出
+func TestChunkLargeSize(t *testing.T) {
+	slice := []int{1, 2, 3}
+	for _, size := range []int{3, 4, math.MaxInt - 1, math.MaxInt} {
+		chunked := Chunk(slice, size)
+		if expected := [][]int{{1, 2, 3}}; !reflect.DeepEqual(chunked, expected) {
+			t.Errorf("Chunk with size %d was incorrect, got %v, expected %v", size, chunked, expected)
+		}
+	}
+}
+
 func TestPop(t *testing.T) {
 	slice := []int{1, 2, 3}
 	lastElem, newSlice := Pop(slice)
@@ -853,3 +865,44 @@ func TestRemove(t *testing.T) {
 		t.Errorf("Expected %v, got %v", expected, removed)
 	}
 }
+
+// 以下类型用于测试具名类型是否满足 Number 和 Ordered 约束
+type Cents int64
+type Score float64
+type UserID string
+
+func TestNamedNumberTypes(t *testing.T) {
+	prices := []Cents{300, -100, 200}
+
+	assert.Equal(t, Cents(400), Sum(prices, func(c Cents) Cents { return c }))
+	assert.Equal(t, []Cents{-100, 200, 300}, Sort([]Cents{300, -100, 200}))
+	assert.Equal(t, []Cents{300, 200}, Filter(prices, IsPositiveFunc[Cents]))
+	assert.Equal(t, []Cents{-100}, Filter(prices, IsNegativeFunc[Cents]))
+	assert.Equal(t, []Cents{300, -100, 200}, Filter(prices, IsNotZeroFunc[Cents]))
+
+	scores := []Score{1.5, 2.5, 2.5}
+	assert.Equal(t, float64(13)/6, Mean(scores))
+	assert.Equal(t, 2.5, Median(scores))
+	assert.Equal(t, Score(2.5), Mode(scores))
+	assert.Equal(t, 2.5, Percentile(scores, 100, PercentileLinear))
+	assert.Len(t, Histogram(scores, 2), 2)
+	assert.True(t, IsSorted(scores))
+
+	ptrs := []uintptr{3, 1, 2}
+	assert.Equal(t, uintptr(6), Sum(ptrs, func(p uintptr) uintptr { return p }))
+	assert.Equal(t, []uintptr{1, 2, 3}, Sort(ptrs))
+}
+
+func TestNamedOrderedTypes(t *testing.T) {
+	ids := Sort([]UserID{"c", "a", "b"})
+	assert.Equal(t, []UserID{"a", "b", "c"}, ids)
+
+	i, found := BinarySearch(ids, UserID("b"))
+	assert.True(t, found)
+	assert.Equal(t, 1, i)
+	assert.Equal(t, []UserID{"a", "b", "bb", "c"}, InsertSorted(ids, "bb"))
+	assert.Equal(t, []UserID{"a", "c"}, RemoveSorted(ids, "b"))
+	assert.Equal(t, []UserID{"a", "b"}, SortedUniq([]UserID{"a", "a", "b"}))
+	assert.Equal(t, 2, UpperBound(ids, "b"))
+	assert.Equal(t, 1, LowerBound(ids, "b"))
+}
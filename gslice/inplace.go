@@ -0,0 +1,77 @@
+package gslice
+
+// the functions in this file work in place: they reuse the backing array of the input slice,
+// zero the elements left after the end of the result so they can be garbage collected,
+// and return the shortened slice. the input slice must not be used after the call.
+//
+// none of them allocate, except UniqInPlace and UniqByInPlace which allocate a map to track seen keys.
+
+// FilterInPlace keeps the elements in slice that match the given condition
+func FilterInPlace[T any](slice []T, f func(T) bool) []T {
+	n := 0
+	for _, v := range slice {
+		if f(v) {
+			slice[n] = v
+			n++
+		}
+	}
+	return truncate(slice, n)
+}
+
+// RemoveIf removes the elements in slice that match the given condition
+func RemoveIf[T any](slice []T, f func(T) bool) []T {
+	return FilterInPlace(slice, func(v T) bool { return !f(v) })
+}
+
+// UniqInPlace removes duplicate elements from slice, the first occurrence is kept
+func UniqInPlace[T comparable](slice []T) []T {
+	return UniqByInPlace(slice, func(v T) T { return v })
+}
+
+// UniqByInPlace removes duplicate elements from slice by keyFunc, the first occurrence is kept
+func UniqByInPlace[T any, K comparable](slice []T, keyFunc func(T) K) []T {
+	seen := make(map[K]struct{}, len(slice))
+	return FilterInPlace(slice, func(v T) bool {
+		k := keyFunc(v)
+		if _, ok := seen[k]; ok {
+			return false
+		}
+		seen[k] = struct{}{}
+		return true
+	})
+}
+
+// Compact replaces runs of consecutive equal elements with a single element,
+// e.g. [1, 1, 2, 1] => [1, 2, 1]
+func Compact[T comparable](slice []T) []T {
+	return CompactBy(slice, func(v T) T { return v })
+}
+
+// CompactBy replaces runs of consecutive elements with the same key with the first element of the run
+func CompactBy[T any, K comparable](slice []T, keyFunc func(T) K) []T {
+	if len(slice) == 0 {
+		return slice
+	}
+
+	n := 1
+	last := keyFunc(slice[0])
+	for i := 1; i < len(slice); i++ {
+		k := keyFunc(slice[i])
+		if k == last {
+			continue
+		}
+		last = k
+		slice[n] = slice[i]
+		n++
+	}
+	return truncate(slice, n)
+}
+
+// truncate zeroes slice[n:] and returns slice[:n]
+func truncate[T any](slice []T, n int) []T {
+	var zeroValue T
+	for i := n; i < len(slice); i++ {
+		slice[i] = zeroValue
+	}
+	return slice[:n]
+}
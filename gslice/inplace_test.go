@@ -0,0 +1,66 @@
+package gslice
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilterInPlace(t *testing.T) {
+	input := []int{1, 2, 3, 4, 5}
+	result := FilterInPlace(input, func(v int) bool { return v%2 == 1 })
+
+	assert.Equal(t, []int{1, 3, 5}, result)
+	assert.Equal(t, &input[0], &result[0], "FilterInPlace should reuse the backing array")
+	assert.Equal(t, []int{1, 3, 5, 0, 0}, input, "the tail should be zeroed")
+
+	assert.Equal(t, []int{}, FilterInPlace([]int{}, IsPositiveFunc[int]))
+	assert.Equal(t, []int{}, FilterInPlace([]int{-1}, IsPositiveFunc[int]))
+}
+
+func TestRemoveIf(t *testing.T) {
+	input := []string{"a", "", "b", ""}
+	result := RemoveIf(input, func(s string) bool { return s == "" })
+	assert.Equal(t, []string{"a", "b"}, result)
+	assert.Equal(t, []string{"a", "b", "", ""}, input)
+}
+
+func TestUniqInPlace(t *testing.T) {
+	a, b, c := new(int), new(int), new(int)
+	input := []*int{a, b, a, c, b}
+	result := UniqInPlace(input)
+
+	assert.Equal(t, []*int{a, b, c}, result)
+	assert.Equal(t, []*int{a, b, c, nil, nil}, input, "the tail should be released for GC")
+
+	people := []Person{{"Alice", 30}, {"Bob", 25}, {"Carol", 30}}
+	assert.Equal(t, []Person{{"Alice", 30}, {"Bob", 25}}, UniqByInPlace(people, func(p Person) int { return p.Age }))
+
+	assert.Equal(t, []int{}, UniqInPlace([]int{}))
+}
+
+func TestCompact(t *testing.T) {
+	input := []int{1, 1, 2, 2, 2, 1, 3, 3}
+	result := Compact(input)
+	assert.Equal(t, []int{1, 2, 1, 3}, result)
+	assert.Equal(t, []int{1, 2, 1, 3, 0, 0, 0, 0}, input)
+
+	assert.Equal(t, []int{}, Compact([]int{}))
+	assert.Equal(t, []int{1}, Compact([]int{1}))
+
+	people := []Person{{"Alice", 30}, {"Bob", 30}, {"Carol", 25}}
+	assert.Equal(t, []Person{{"Alice", 30}, {"Carol", 25}}, CompactBy(people, func(p Person) int { return p.Age }))
+}
+
+func TestInPlaceAllocations(t *testing.T) {
+	input := make([]int, 100)
+	allocs := testing.AllocsPerRun(10, func() {
+		for i := range input {
+			input[i] = i % 10
+		}
+		FilterInPlace(input, IsPositiveFunc[int])
+		RemoveIf(input, IsPositiveFunc[int])
+		Compact(input)
+	})
+	assert.Equal(t, 0.0, allocs)
+}
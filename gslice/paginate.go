@@ -0,0 +1,114 @@
+package gslice
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// ErrInvalidCursor is returned by PaginateCursor when the cursor can't be decoded
+var ErrInvalidCursor = errors.New("gslice: invalid cursor")
+
+// Page is a page of elements returned by Paginate
+type Page[T any] struct {
+	// Items shares the backing array of the paginated slice and is capped at its length like Chunk
+	Items []T
+	// Page is the requested 1-based page number
+	Page int
+	Size int
+	// Total is the number of elements of the paginated slice
+	Total int
+	Pages int
+	// HasNext and HasPrev report whether the pages Page+1 and Page-1 have items
+	HasNext bool
+	HasPrev bool
+}
+
+// Paginate return the page-th page (1-based) of slice with size elements per page and its metadata,
+// the pages are the chunks of Chunk(slice, size)
+//
+// Items is empty if page is out of [1, Pages] or size <= 0, so a page past the end never repeats the last one.
+func Paginate[T any](slice []T, page int, size int) Page[T] {
+	result := Page[T]{Items: make([]T, 0), Page: page, Size: size, Total: len(slice)}
+	if size <= 0 {
+		return result
+	}
+
+	result.Pages = chunkCount(len(slice), size)
+	result.HasNext = page >= 0 && page < result.Pages
+	result.HasPrev = page >= 2 && page <= result.Pages+1
+	if page >= 1 && page <= result.Pages {
+		result.Items = chunkAt(slice, size, page-1)
+	}
+	return result
+}
+
+// CursorPage is a page of elements returned by PaginateCursor
+type CursorPage[T any] struct {
+	Items []T
+	// NextCursor is the cursor of the next page, empty if there is none
+	NextCursor string
+	HasNext    bool
+}
+
+// PaginateCursor return at most size elements of sortedSlice whose key is greater than the key encoded in cursor,
+// an empty cursor starts from the first element
+//
+// sortedSlice must be sorted in ascending order of unique keys returned by keyFunc.
+// cursors are opaque strings that stay valid when elements are inserted or removed,
+// ErrInvalidCursor is returned if cursor wasn't returned by PaginateCursor with the same key type.
+func PaginateCursor[T any, K Ordered](sortedSlice []T, keyFunc func(T) K, cursor string, size int) (CursorPage[T], error) {
+	result := CursorPage[T]{Items: make([]T, 0)}
+
+	start := 0
+	if cursor != "" {
+		after, err := decodeCursor[K](cursor)
+		if err != nil {
+			return CursorPage[T]{}, err
+		}
+		start = sort.Search(len(sortedSlice), func(i int) bool {
+			return keyFunc(sortedSlice[i]) > after
+		})
+	}
+	if size <= 0 || start == len(sortedSlice) {
+		return result, nil
+	}
+
+	end := len(sortedSlice)
+	if size < end-start {
+		end = start + size
+	}
+	result.Items = sortedSlice[start:end:end]
+	if end < len(sortedSlice) {
+		next, err := encodeCursor(keyFunc(sortedSlice[end-1]))
+		if err != nil {
+			return CursorPage[T]{}, err
+		}
+		result.NextCursor = next
+		result.HasNext = true
+	}
+	return result, nil
+}
+
+// encodeCursor encodes key as base64url of its JSON representation
+func encodeCursor[K Ordered](key K) (string, error) {
+	data, err := json.Marshal(key)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+func decodeCursor[K Ordered](cursor string) (K, error) {
+	var key K
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return key, fmt.Errorf("%w: %v", ErrInvalidCursor, err)
+	}
+	if err := json.Unmarshal(data, &key); err != nil {
+		return key, fmt.Errorf("%w: %v", ErrInvalidCursor, err)
+	}
+	return key, nil
+}
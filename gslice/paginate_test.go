@@ -0,0 +1,107 @@
+package gslice
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPaginate(t *testing.T) {
+	slice := []int{1, 2, 3, 4, 5, 6, 7}
+
+	tests := []struct {
+		name     string
+		page     int
+		size     int
+		expected Page[int]
+	}{
+		{"first", 1, 3, Page[int]{Items: []int{1, 2, 3}, Page: 1, Size: 3, Total: 7, Pages: 3, HasNext: true}},
+		{"middle", 2, 3, Page[int]{Items: []int{4, 5, 6}, Page: 2, Size: 3, Total: 7, Pages: 3, HasNext: true, HasPrev: true}},
+		{"last partial", 3, 3, Page[int]{Items: []int{7}, Page: 3, Size: 3, Total: 7, Pages: 3, HasPrev: true}},
+		{"just past the end", 4, 3, Page[int]{Items: []int{}, Page: 4, Size: 3, Total: 7, Pages: 3, HasPrev: true}},
+		{"far past the end", 10, 3, Page[int]{Items: []int{}, Page: 10, Size: 3, Total: 7, Pages: 3}},
+		{"zero", 0, 3, Page[int]{Items: []int{}, Page: 0, Size: 3, Total: 7, Pages: 3, HasNext: true}},
+		{"negative", -1, 3, Page[int]{Items: []int{}, Page: -1, Size: 3, Total: 7, Pages: 3}},
+		{"exact", 1, 7, Page[int]{Items: slice, Page: 1, Size: 7, Total: 7, Pages: 1}},
+		{"size too large", 1, 100, Page[int]{Items: slice, Page: 1, Size: 100, Total: 7, Pages: 1}},
+		{"invalid size", 1, 0, Page[int]{Items: []int{}, Page: 1, Size: 0, Total: 7}},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, Paginate(slice, tt.page, tt.size), tt.name)
+	}
+
+	assert.Equal(t, Page[int]{Items: []int{}, Page: 1, Size: 3}, Paginate([]int{}, 1, 3))
+
+	// walking the pages until HasNext is false visits every element exactly once
+	var seen []int
+	for page := 1; ; page++ {
+		p := Paginate(slice, page, 2)
+		seen = append(seen, p.Items...)
+		if !p.HasNext {
+			break
+		}
+	}
+	assert.Equal(t, slice, seen)
+
+	for i, chunk := range Chunk(slice, 3) {
+		assert.Equal(t, chunk, Paginate(slice, i+1, 3).Items, "page %d should be the same as Chunk", i+1)
+	}
+
+	page := Paginate(slice, 1, 3)
+	_ = append(page.Items, -1)
+	assert.Equal(t, 4, slice[3], "appending to a page should not overwrite the next page")
+}
+
+func TestPaginateLargeSize(t *testing.T) {
+	slice := []int{1, 2, 3}
+	for _, size := range []int{3, math.MaxInt - 1, math.MaxInt} {
+		expected := Page[int]{Items: slice, Page: 1, Size: size, Total: 3, Pages: 1}
+		assert.Equal(t, expected, Paginate(slice, 1, size), "size %d", size)
+	}
+	assert.Equal(t, Page[int]{Items: []int{}, Page: 2, Size: math.MaxInt, Total: 3, Pages: 1, HasPrev: true},
+		Paginate(slice, 2, math.MaxInt))
+}
+
+func TestPaginateCursor(t *testing.T) {
+	people := []Person{{"Alice", 1}, {"Bob", 2}, {"Carol", 3}, {"Dave", 4}, {"Eve", 5}}
+	byName := func(p Person) string { return p.Name }
+
+	first, err := PaginateCursor(people, byName, "", 2)
+	assert.NoError(t, err)
+	assert.Equal(t, people[:2], first.Items)
+	assert.True(t, first.HasNext)
+
+	// inserting before the cursor doesn't shift the next page
+	inserted := []Person{{"Aaron", 0}, {"Alice", 1}, {"Bob", 2}, {"Bobby", 6}, {"Carol", 3}, {"Dave", 4}, {"Eve", 5}}
+	second, err := PaginateCursor(inserted, byName, first.NextCursor, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []Person{{"Bobby", 6}, {"Carol", 3}}, second.Items)
+
+	last, err := PaginateCursor(people, byName, second.NextCursor, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []Person{{"Dave", 4}, {"Eve", 5}}, last.Items)
+	assert.False(t, last.HasNext)
+	assert.Equal(t, "", last.NextCursor)
+
+	ints := []int{10, 20, 30}
+	page, err := PaginateCursor(ints, identity[int], "", 2)
+	assert.NoError(t, err)
+	page, err = PaginateCursor(ints, identity[int], page.NextCursor, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, CursorPage[int]{Items: []int{30}}, page)
+
+	_, err = PaginateCursor(ints, identity[int], "not a cursor!", 2)
+	assert.True(t, errors.Is(err, ErrInvalidCursor))
+	_, err = PaginateCursor(ints, identity[int], first.NextCursor, 2)
+	assert.True(t, errors.Is(err, ErrInvalidCursor), "a string cursor should not decode as an int key")
+
+	page, err = PaginateCursor(ints, identity[int], "MTA", math.MaxInt)
+	assert.NoError(t, err)
+	assert.Equal(t, CursorPage[int]{Items: []int{20, 30}}, page, "a huge size should not overflow")
+
+	empty, err := PaginateCursor([]int{}, identity[int], "", 2)
+	assert.NoError(t, err)
+	assert.Equal(t, CursorPage[int]{Items: []int{}}, empty)
+}
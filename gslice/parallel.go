@@ -0,0 +1,152 @@
+package gslice
+
+import (
+	"context"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// ParallelMap apply function f to each element of a slice using at most 'workers' goroutines
+// and return a new slice in the same order as the input.
+//
+// if workers <= 0, runtime.GOMAXPROCS(0) workers are used.
+// the first error returned by f (or the cancellation of ctx) stops the remaining work
+// and is returned together with a nil slice.
+func ParallelMap[T, U any](ctx context.Context, slice []T, workers int, f func(T) (U, error)) ([]U, error) {
+	if f == nil {
+		return []U{}, nil
+	}
+
+	result := make([]U, len(slice))
+	err := parallelDo(ctx, len(slice), workers, func(i int) error {
+		v, err := f(slice[i])
+		if err != nil {
+			return err
+		}
+		result[i] = v
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ParallelFilter return elements in slice that match the given condition,
+// evaluating the condition with at most 'workers' goroutines.
+//
+// the order of the result is the same as Filter.
+func ParallelFilter[T any](ctx context.Context, slice []T, workers int, f func(T) (bool, error)) ([]T, error) {
+	if f == nil {
+		return []T{}, nil
+	}
+
+	keep := make([]bool, len(slice))
+	err := parallelDo(ctx, len(slice), workers, func(i int) error {
+		ok, err := f(slice[i])
+		if err != nil {
+			return err
+		}
+		keep[i] = ok
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]T, 0)
+	for i, v := range slice {
+		if keep[i] {
+			result = append(result, v)
+		}
+	}
+	return result, nil
+}
+
+// ParallelForEach apply f to each element of slice using at most 'workers' goroutines.
+//
+// elements are not guaranteed to be visited in order.
+func ParallelForEach[T any](ctx context.Context, slice []T, workers int, f func(T) error) error {
+	if f == nil {
+		return nil
+	}
+
+	return parallelDo(ctx, len(slice), workers, func(i int) error {
+		return f(slice[i])
+	})
+}
+
+// parallelDo calls f for every index in [0, n) with a bounded number of goroutines.
+// it returns the first error returned by f, or ctx.Err() if ctx is canceled before all indexes are done.
+// a panic in f stops the remaining work and is re-raised on the caller's goroutine.
+func parallelDo(ctx context.Context, n, workers int, f func(i int) error) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if n == 0 {
+		return nil
+	}
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	if workers > n {
+		workers = n
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		next       int64 = -1
+		once       sync.Once
+		firstErr   error
+		panicOnce  sync.Once
+		panicked   bool
+		panicValue any
+		wg         sync.WaitGroup
+	)
+	fail := func(err error) {
+		once.Do(func() {
+			firstErr = err
+			cancel()
+		})
+	}
+
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			defer func() {
+				if p := recover(); p != nil {
+					panicOnce.Do(func() {
+						panicked, panicValue = true, p
+						cancel()
+					})
+				}
+			}()
+			for {
+				// check for exhaustion first so that a cancellation after the last index is done
+				// doesn't throw away a complete result
+				i := int(atomic.AddInt64(&next, 1))
+				if i >= n {
+					return
+				}
+				if err := ctx.Err(); err != nil {
+					fail(err)
+					return
+				}
+				if err := f(i); err != nil {
+					fail(err)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if panicked {
+		panic(panicValue)
+	}
+	return firstErr
+}
@@ -0,0 +1,183 @@
+package gslice
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestParallelMap(t *testing.T) {
+	input := make([]int, 1000)
+	expected := make([]int, 1000)
+	for i := range input {
+		input[i] = i
+		expected[i] = i * i
+	}
+
+	result, err := ParallelMap(context.Background(), input, 8, func(v int) (int, error) {
+		return v * v, nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("ParallelMap was incorrect, got %v, expected %v", result, expected)
+	}
+}
+
+func TestParallelMapEmptySlice(t *testing.T) {
+	var input []int
+	result, err := ParallelMap(context.Background(), input, 0, func(v int) (string, error) {
+		return "", nil
+	})
+	if err != nil || !reflect.DeepEqual(result, []string{}) {
+		t.Errorf("Expected empty slice, got %v, %v", result, err)
+	}
+}
+
+func TestParallelMapError(t *testing.T) {
+	boom := errors.New("boom")
+	var calls int64
+	input := make([]int, 10000)
+	result, err := ParallelMap(context.Background(), input, 4, func(v int) (int, error) {
+		if atomic.AddInt64(&calls, 1) == 10 {
+			return 0, boom
+		}
+		return v, nil
+	})
+	if !errors.Is(err, boom) {
+		t.Errorf("Expected %v, got %v", boom, err)
+	}
+	if result != nil {
+		t.Errorf("Expected nil result, got %v", result)
+	}
+	if atomic.LoadInt64(&calls) == int64(len(input)) {
+		t.Errorf("Expected ParallelMap to stop early")
+	}
+}
+
+func TestParallelMapCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := ParallelMap(ctx, []int{1, 2, 3}, 2, func(v int) (int, error) {
+		t.Errorf("f should not be called")
+		return v, nil
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected %v, got %v", context.Canceled, err)
+	}
+}
+
+func TestParallelMapWorkerLimit(t *testing.T) {
+	var running, peak int64
+	input := make([]int, 64)
+	_, err := ParallelMap(context.Background(), input, 3, func(v int) (int, error) {
+		n := atomic.AddInt64(&running, 1)
+		for {
+			p := atomic.LoadInt64(&peak)
+			if n <= p || atomic.CompareAndSwapInt64(&peak, p, n) {
+				break
+			}
+		}
+		time.Sleep(time.Millisecond)
+		atomic.AddInt64(&running, -1)
+		return v, nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if peak > 3 {
+		t.Errorf("Expected at most 3 concurrent calls, got %d", peak)
+	}
+}
+
+func TestParallelFilter(t *testing.T) {
+	input := []int{-1, 0, 1, 2, 3, 4}
+	expected := []int{0, 2, 4}
+
+	result, err := ParallelFilter(context.Background(), input, 2, func(v int) (bool, error) {
+		return v%2 == 0, nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("ParallelFilter was incorrect, got %v, expected %v", result, expected)
+	}
+}
+
+func TestParallelForEach(t *testing.T) {
+	var sum int64
+	err := ParallelForEach(context.Background(), []int{1, 2, 3, 4}, 0, func(v int) error {
+		atomic.AddInt64(&sum, int64(v))
+		return nil
+	})
+	if err != nil || sum != 10 {
+		t.Errorf("Expected sum 10, got %d, %v", sum, err)
+	}
+
+	boom := errors.New("boom")
+	err = ParallelForEach(context.Background(), []int{1, 2, 3}, 1, func(v int) error {
+		if v == 2 {
+			return boom
+		}
+		return nil
+	})
+	if !errors.Is(err, boom) {
+		t.Errorf("Expected %v, got %v", boom, err)
+	}
+}
+
+func TestParallelMapPanic(t *testing.T) {
+	defer func() {
+		if r := recover(); r != "boom" {
+			t.Errorf("Expected the panic of f on the caller's goroutine, got %v", r)
+		}
+	}()
+	_, _ = ParallelMap(context.Background(), make([]int, 100), 4, func(v int) (int, error) {
+		panic("boom")
+	})
+	t.Errorf("Expected ParallelMap to panic")
+}
+
+func TestParallelForEachPanic(t *testing.T) {
+	var calls int64
+	defer func() {
+		if r := recover(); r != "boom" {
+			t.Errorf("Expected the panic of f on the caller's goroutine, got %v", r)
+		}
+		if atomic.LoadInt64(&calls) == 10000 {
+			t.Errorf("Expected ParallelForEach to stop early")
+		}
+	}()
+	_ = ParallelForEach(context.Background(), make([]int, 10000), 4, func(v int) error {
+		if atomic.AddInt64(&calls, 1) == 10 {
+			panic("boom")
+		}
+		return nil
+	})
+	t.Errorf("Expected ParallelForEach to panic")
+}
+
+func TestParallelMapCanceledAfterLast(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	input := []int{1, 2, 3}
+	result, err := ParallelMap(ctx, input, 1, func(v int) (int, error) {
+		if v == 3 {
+			cancel()
+		}
+		return v * 2, nil
+	})
+	if err != nil {
+		t.Errorf("Expected no error once every element is done, got %v", err)
+	}
+	if !reflect.DeepEqual(result, []int{2, 4, 6}) {
+		t.Errorf("Expected [2 4 6], got %v", result)
+	}
+}
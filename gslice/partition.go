@@ -0,0 +1,112 @@
+package gslice
+
+// the functions in this file which return parts of the input slice return sub-slices sharing its backing array,
+// they are capped at their length so appending to a part never overwrites the following elements.
+
+// Run is a run of Count consecutive elements equal to Value
+type Run[T any] struct {
+	Value T
+	Count int
+}
+
+// Partition splits slice into the elements that match the condition and those that don't, in one pass
+func Partition[T any](slice []T, f func(T) bool) ([]T, []T) {
+	matched := make([]T, 0)
+	unmatched := make([]T, 0)
+	for _, v := range slice {
+		if f(v) {
+			matched = append(matched, v)
+		} else {
+			unmatched = append(unmatched, v)
+		}
+	}
+	return matched, unmatched
+}
+
+// PartitionBy splits slice into runs of consecutive elements which have the same key,
+// e.g. [1, 3, 2, 4, 5] partitioned by parity => [[1, 3], [2, 4], [5]]
+func PartitionBy[T any, K comparable](slice []T, keyFunc func(T) K) [][]T {
+	return ChunkWhile(slice, func(prev, next T) bool {
+		return keyFunc(prev) == keyFunc(next)
+	})
+}
+
+// ChunkWhile splits slice between each pair of neighbours for which f(prev, next) is false,
+// e.g. [1, 2, 4, 5, 7] chunked while next == prev+1 => [[1, 2], [4, 5], [7]]
+func ChunkWhile[T any](slice []T, f func(prev, next T) bool) [][]T {
+	result := make([][]T, 0)
+	start := 0
+	for i := 1; i <= len(slice); i++ {
+		if i < len(slice) && f(slice[i-1], slice[i]) {
+			continue
+		}
+		result = append(result, slice[start:i:i])
+		start = i
+	}
+	return result
+}
+
+// SplitAt splits slice into the elements before index and the elements from index on,
+// index is clamped to [0, len(slice)]
+func SplitAt[T any](slice []T, index int) ([]T, []T) {
+	if index < 0 {
+		index = 0
+	}
+	if index > len(slice) {
+		index = len(slice)
+	}
+	return slice[:index:index], slice[index:]
+}
+
+// Span splits slice into the longest prefix whose elements match the condition and the rest
+func Span[T any](slice []T, f func(T) bool) ([]T, []T) {
+	i := 0
+	for i < len(slice) && f(slice[i]) {
+		i++
+	}
+	return SplitAt(slice, i)
+}
+
+// TakeWhile return the longest prefix of slice whose elements match the condition
+func TakeWhile[T any](slice []T, f func(T) bool) []T {
+	prefix, _ := Span(slice, f)
+	return prefix
+}
+
+// DropWhile return slice without the longest prefix whose elements match the condition
+func DropWhile[T any](slice []T, f func(T) bool) []T {
+	_, rest := Span(slice, f)
+	return rest
+}
+
+// RunLengthEncode compresses runs of consecutive equal elements,
+// e.g. [a, a, b, a] => [(a, 2), (b, 1), (a, 1)]
+func RunLengthEncode[T comparable](slice []T) []Run[T] {
+	result := make([]Run[T], 0)
+	for _, v := range slice {
+		if n := len(result); n > 0 && result[n-1].Value == v {
+			result[n-1].Count++
+			continue
+		}
+		result = append(result, Run[T]{Value: v, Count: 1})
+	}
+	return result
+}
+
+// RunLengthDecode expands runs created by RunLengthEncode, runs with Count <= 0 are skipped
+func RunLengthDecode[T any](runs []Run[T]) []T {
+	total := 0
+	for _, r := range runs {
+		if r.Count > 0 {
+			total += r.Count
+		}
+	}
+
+	result := make([]T, 0, total)
+	for _, r := range runs {
+		for i := 0; i < r.Count; i++ {
+			result = append(result, r.Value)
+		}
+	}
+	return result
+}
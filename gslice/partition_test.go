@@ -0,0 +1,85 @@
+package gslice
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPartition(t *testing.T) {
+	isEven := func(v int) bool { return v%2 == 0 }
+
+	matched, unmatched := Partition([]int{1, 2, 3, 4, 5}, isEven)
+	assert.Equal(t, []int{2, 4}, matched)
+	assert.Equal(t, []int{1, 3, 5}, unmatched)
+
+	matched, unmatched = Partition([]int{}, isEven)
+	assert.Equal(t, []int{}, matched)
+	assert.Equal(t, []int{}, unmatched)
+}
+
+func TestPartitionBy(t *testing.T) {
+	result := PartitionBy([]int{1, 3, 2, 4, 5}, func(v int) bool { return v%2 == 0 })
+	assert.Equal(t, [][]int{{1, 3}, {2, 4}, {5}}, result)
+	assert.Equal(t, [][]int{}, PartitionBy([]int{}, func(v int) int { return v }))
+}
+
+func TestChunkWhile(t *testing.T) {
+	consecutive := func(prev, next int) bool { return next == prev+1 }
+	assert.Equal(t, [][]int{{1, 2}, {4, 5}, {7}}, ChunkWhile([]int{1, 2, 4, 5, 7}, consecutive))
+	assert.Equal(t, [][]int{{1}}, ChunkWhile([]int{1}, consecutive))
+	assert.Equal(t, [][]int{}, ChunkWhile([]int{}, consecutive))
+}
+
+func TestChunkWhileAppendDoesNotOverwrite(t *testing.T) {
+	input := []int{1, 2, 4, 5}
+	chunks := ChunkWhile(input, func(prev, next int) bool { return next == prev+1 })
+	_ = append(chunks[0], 100)
+	assert.Equal(t, []int{1, 2, 4, 5}, input)
+}
+
+func TestSplitAt(t *testing.T) {
+	input := []int{1, 2, 3}
+	tests := []struct {
+		index       int
+		left, right []int
+	}{
+		{0, []int{}, []int{1, 2, 3}},
+		{1, []int{1}, []int{2, 3}},
+		{3, []int{1, 2, 3}, []int{}},
+		{-1, []int{}, []int{1, 2, 3}},
+		{5, []int{1, 2, 3}, []int{}},
+	}
+	for _, tt := range tests {
+		left, right := SplitAt(input, tt.index)
+		assert.Equal(t, tt.left, left, "SplitAt(%d)", tt.index)
+		assert.Equal(t, tt.right, right, "SplitAt(%d)", tt.index)
+	}
+
+	left, _ := SplitAt(input, 1)
+	_ = append(left, 100)
+	assert.Equal(t, []int{1, 2, 3}, input)
+}
+
+func TestSpan(t *testing.T) {
+	isPositive := IsPositiveFunc[int]
+	prefix, rest := Span([]int{1, 2, -1, 3}, isPositive)
+	assert.Equal(t, []int{1, 2}, prefix)
+	assert.Equal(t, []int{-1, 3}, rest)
+
+	assert.Equal(t, []int{1, 2}, TakeWhile([]int{1, 2, -1, 3}, isPositive))
+	assert.Equal(t, []int{-1, 3}, DropWhile([]int{1, 2, -1, 3}, isPositive))
+	assert.Equal(t, []int{}, TakeWhile([]int{-1}, isPositive))
+	assert.Equal(t, []int{}, DropWhile([]int{1, 2}, isPositive))
+}
+
+func TestRunLength(t *testing.T) {
+	input := []string{"a", "a", "b", "a"}
+	runs := RunLengthEncode(input)
+	assert.Equal(t, []Run[string]{{"a", 2}, {"b", 1}, {"a", 1}}, runs)
+	assert.Equal(t, input, RunLengthDecode(runs))
+
+	assert.Equal(t, []Run[int]{}, RunLengthEncode([]int{}))
+	assert.Equal(t, []int{}, RunLengthDecode([]Run[int]{}))
+	assert.Equal(t, []int{1}, RunLengthDecode([]Run[int]{{2, 0}, {1, 1}, {3, -1}}))
+}
@@ -0,0 +1,142 @@
+package gslice
+
+import (
+	"reflect"
+	"unsafe"
+)
+
+const (
+	// radixThreshold is the minimum length for which radix sort is faster than pdqsort
+	radixThreshold = 256
+	// radixMaxStringLen is the maximum length of the longest string for which strings are radix sorted,
+	// LSD radix sort makes one pass per byte of the longest string
+	radixMaxStringLen = 16
+)
+
+// radixSort sorts slice with LSD radix sort if T is an integer or string type,
+// it reports whether the slice was sorted
+func radixSort[T Ordered](slice []T) bool {
+	if len(slice) < radixThreshold {
+		return false
+	}
+
+	var zeroValue T
+	switch reflect.TypeOf(zeroValue).Kind() {
+	case reflect.Int8:
+		radixSortInts(slice, 1, func(v T) uint64 { return uint64(*(*int8)(unsafe.Pointer(&v))) ^ 1<<7 })
+	case reflect.Int16:
+		radixSortInts(slice, 2, func(v T) uint64 { return uint64(*(*int16)(unsafe.Pointer(&v))) ^ 1<<15 })
+	case reflect.Int32:
+		radixSortInts(slice, 4, func(v T) uint64 { return uint64(*(*int32)(unsafe.Pointer(&v))) ^ 1<<31 })
+	case reflect.Int64:
+		radixSortInts(slice, 8, func(v T) uint64 { return uint64(*(*int64)(unsafe.Pointer(&v))) ^ 1<<63 })
+	case reflect.Int:
+		radixSortInts(slice, 8, func(v T) uint64 { return uint64(*(*int)(unsafe.Pointer(&v))) ^ 1<<63 })
+	case reflect.Uint8:
+		radixSortInts(slice, 1, func(v T) uint64 { return uint64(*(*uint8)(unsafe.Pointer(&v))) })
+	case reflect.Uint16:
+		radixSortInts(slice, 2, func(v T) uint64 { return uint64(*(*uint16)(unsafe.Pointer(&v))) })
+	case reflect.Uint32:
+		radixSortInts(slice, 4, func(v T) uint64 { return uint64(*(*uint32)(unsafe.Pointer(&v))) })
+	case reflect.Uint64:
+		radixSortInts(slice, 8, func(v T) uint64 { return *(*uint64)(unsafe.Pointer(&v)) })
+	case reflect.Uint:
+		radixSortInts(slice, 8, func(v T) uint64 { return uint64(*(*uint)(unsafe.Pointer(&v))) })
+	case reflect.Uintptr:
+		radixSortInts(slice, 8, func(v T) uint64 { return uint64(*(*uintptr)(unsafe.Pointer(&v))) })
+	case reflect.String:
+		return radixSortStrings(slice, func(v T) string { return *(*string)(unsafe.Pointer(&v)) })
+	default:
+		return false
+	}
+	return true
+}
+
+// radixSortInts sorts slice by the unsigned keys returned by key, which must preserve the order of T,
+// size is the number of significant bytes of the keys
+func radixSortInts[T any](slice []T, size int, key func(T) uint64) {
+	n := len(slice)
+	keys := make([]uint64, n)
+	for i, v := range slice {
+		keys[i] = key(v)
+	}
+
+	values, bufValues := slice, make([]T, n)
+	bufKeys := make([]uint64, n)
+	for shift := 0; shift < size*8; shift += 8 {
+		var offsets [256]int
+		for _, k := range keys {
+			offsets[byte(k>>shift)]++
+		}
+		// every key has the same byte, nothing to do in this pass
+		if offsets[byte(keys[0]>>shift)] == n {
+			continue
+		}
+
+		sum := 0
+		for b, count := range offsets {
+			offsets[b] = sum
+			sum += count
+		}
+		for i, k := range keys {
+			b := byte(k >> shift)
+			bufKeys[offsets[b]] = k
+			bufValues[offsets[b]] = values[i]
+			offsets[b]++
+		}
+		keys, bufKeys = bufKeys, keys
+		values, bufValues = bufValues, values
+	}
+
+	if &values[0] != &slice[0] {
+		copy(slice, values)
+	}
+}
+
+// radixSortStrings sorts slice by the strings returned by str, one pass per byte from the last one,
+// it gives up and reports false if the longest string is longer than radixMaxStringLen
+func radixSortStrings[T any](slice []T, str func(T) string) bool {
+	maxLen := 0
+	for _, v := range slice {
+		if l := len(str(v)); l > maxLen {
+			if l > radixMaxStringLen {
+				return false
+			}
+			maxLen = l
+		}
+	}
+
+	n := len(slice)
+	values, buf := slice, make([]T, n)
+	for p := maxLen - 1; p >= 0; p-- {
+		// bucket 0 holds the strings shorter than p+1, they sort before any byte
+		var offsets [257]int
+		for _, v := range values {
+			offsets[stringBucket(str(v), p)]++
+		}
+
+		sum := 0
+		for b, count := range offsets {
+			offsets[b] = sum
+			sum += count
+		}
+		for _, v := range values {
+			b := stringBucket(str(v), p)
+			buf[offsets[b]] = v
+			offsets[b]++
+		}
+		values, buf = buf, values
+	}
+
+	if n > 0 && &values[0] != &slice[0] {
+		copy(slice, values)
+	}
+	return true
+}
+
+func stringBucket(s string, p int) int {
+	if p >= len(s) {
+		return 0
+	}
+	return int(s[p]) + 1
+}
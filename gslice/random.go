@@ -0,0 +1,185 @@
+package gslice
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+)
+
+// the functions in this file take a *rand.Rand as the source of randomness,
+// pass a seeded source to get reproducible results, or nil to use the default source of math/rand.
+
+func randIntn(r *rand.Rand, n int) int {
+	if r == nil {
+		return rand.Intn(n)
+	}
+	return r.Intn(n)
+}
+
+func randFloat64(r *rand.Rand) float64 {
+	if r == nil {
+		return rand.Float64()
+	}
+	return r.Float64()
+}
+
+// Shuffle return a new slice with the elements of slice in random order
+func Shuffle[T any](slice []T, r *rand.Rand) []T {
+	result := make([]T, len(slice))
+	copy(result, slice)
+	return ShuffleInPlace(result, r)
+}
+
+// ShuffleInPlace shuffles the elements of slice in place (Fisher-Yates) and returns it
+func ShuffleInPlace[T any](slice []T, r *rand.Rand) []T {
+	for i := len(slice) - 1; i > 0; i-- {
+		j := randIntn(r, i+1)
+		slice[i], slice[j] = slice[j], slice[i]
+	}
+	return slice
+}
+
+// Sample return n elements picked randomly from slice without replacement,
+// all elements are returned (in random order) if n >= len(slice)
+func Sample[T any](slice []T, n int, r *rand.Rand) []T {
+	if n <= 0 {
+		return []T{}
+	}
+	if n > len(slice) {
+		n = len(slice)
+	}
+
+	// partial Fisher-Yates on a copy, only the first n positions are shuffled
+	result := make([]T, len(slice))
+	copy(result, slice)
+	for i := 0; i < n; i++ {
+		j := i + randIntn(r, len(result)-i)
+		result[i], result[j] = result[j], result[i]
+	}
+	return result[:n:n]
+}
+
+// SampleWithReplacement return n elements picked randomly from slice, an element may be picked several times
+func SampleWithReplacement[T any](slice []T, n int, r *rand.Rand) []T {
+	if n <= 0 || len(slice) == 0 {
+		return []T{}
+	}
+
+	result := make([]T, n)
+	for i := range result {
+		result[i] = slice[randIntn(r, len(slice))]
+	}
+	return result
+}
+
+// WeightedChoice return an element picked randomly with a probability proportional to its weight,
+// elements with a weight <= 0 are never picked and false is returned if there is nothing to pick
+func WeightedChoice[T any](slice []T, weight func(T) float64, r *rand.Rand) (T, bool) {
+	weights := make([]float64, len(slice))
+	total := 0.0
+	for i, v := range slice {
+		if w := weight(v); w > 0 {
+			weights[i] = w
+			total += w
+		}
+	}
+
+	var zeroValue T
+	if total <= 0 {
+		return zeroValue, false
+	}
+
+	target := randFloat64(r) * total
+	last := -1
+	for i, w := range weights {
+		if w <= 0 {
+			continue
+		}
+		last = i
+		if target < w {
+			return slice[i], true
+		}
+		target -= w
+	}
+	// rounding errors may leave a tiny remainder, fall back to the last candidate
+	return slice[last], true
+}
+
+// WeightedSample return n elements picked randomly without replacement, with a probability proportional to their weight
+// (Efraimidis-Spirakis), elements with a weight <= 0 are never picked
+func WeightedSample[T any](slice []T, n int, weight func(T) float64, r *rand.Rand) []T {
+	type candidate struct {
+		key   float64
+		index int
+	}
+
+	candidates := make([]candidate, 0, len(slice))
+	for i, v := range slice {
+		w := weight(v)
+		if w <= 0 {
+			continue
+		}
+		// u^(1/w) compared in log space to avoid underflow, 1-Float64 is in (0, 1]
+		candidates = append(candidates, candidate{key: math.Log(1-randFloat64(r)) / w, index: i})
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].key > candidates[j].key
+	})
+	if n > len(candidates) {
+		n = len(candidates)
+	}
+	if n < 0 {
+		n = 0
+	}
+
+	result := make([]T, n)
+	for i := range result {
+		result[i] = slice[candidates[i].index]
+	}
+	return result
+}
+
+// Reservoir keeps a uniform random sample of at most size elements from a stream of unknown length
+type Reservoir[T any] struct {
+	size  int
+	count int
+	items []T
+	r     *rand.Rand
+}
+
+// NewReservoir creates a Reservoir which keeps at most size elements
+func NewReservoir[T any](size int, r *rand.Rand) *Reservoir[T] {
+	if size < 0 {
+		size = 0
+	}
+	return &Reservoir[T]{
+		size:  size,
+		items: make([]T, 0, size),
+		r:     r,
+	}
+}
+
+// Add offers v to the reservoir
+func (s *Reservoir[T]) Add(v T) {
+	s.count++
+	if len(s.items) < s.size {
+		s.items = append(s.items, v)
+		return
+	}
+	if j := randIntn(s.r, s.count); j < s.size {
+		s.items[j] = v
+	}
+}
+
+// Items return a copy of the sampled elements
+func (s *Reservoir[T]) Items() []T {
+	result := make([]T, len(s.items))
+	copy(result, s.items)
+	return result
+}
+
+// Count return the number of elements offered to the reservoir
+func (s *Reservoir[T]) Count() int {
+	return s.count
+}
@@ -0,0 +1,118 @@
+package gslice
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShuffle(t *testing.T) {
+	input := []int{1, 2, 3, 4, 5, 6, 7, 8}
+	result := Shuffle(input, rand.New(rand.NewSource(1)))
+
+	assert.Equal(t, []int{1, 2, 3, 4, 5, 6, 7, 8}, input, "Shuffle should not modify the input")
+	assert.ElementsMatch(t, input, result)
+	assert.Equal(t, result, Shuffle(input, rand.New(rand.NewSource(1))), "same seed should give the same result")
+
+	inPlace := []int{1, 2, 3, 4, 5, 6, 7, 8}
+	ShuffleInPlace(inPlace, rand.New(rand.NewSource(1)))
+	assert.Equal(t, result, inPlace)
+
+	assert.Equal(t, []int{}, Shuffle([]int{}, nil))
+}
+
+func TestSample(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	input := []int{1, 2, 3, 4, 5}
+
+	result := Sample(input, 3, r)
+	assert.Len(t, result, 3)
+	assert.Len(t, Uniq(result), 3)
+	assert.Subset(t, input, result)
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, input)
+
+	assert.ElementsMatch(t, input, Sample(input, 10, r))
+	assert.Equal(t, []int{}, Sample(input, 0, r))
+	assert.Equal(t, []int{}, Sample([]int{}, 2, r))
+}
+
+func TestSampleWithReplacement(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	result := SampleWithReplacement([]int{1, 2}, 10, r)
+	assert.Len(t, result, 10)
+	assert.Subset(t, []int{1, 2}, result)
+
+	assert.Equal(t, []int{}, SampleWithReplacement([]int{}, 3, r))
+	assert.Equal(t, []int{}, SampleWithReplacement([]int{1}, 0, r))
+}
+
+func TestWeightedChoice(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	weight := func(v int) float64 { return float64(v) }
+
+	counts := map[int]int{}
+	for i := 0; i < 10000; i++ {
+		v, ok := WeightedChoice([]int{0, 1, 3}, weight, r)
+		assert.True(t, ok)
+		counts[v]++
+	}
+	assert.Equal(t, 0, counts[0], "zero weight should never be picked")
+	assert.InDelta(t, 0.75, float64(counts[3])/10000, 0.03)
+
+	_, ok := WeightedChoice([]int{0, -1}, weight, r)
+	assert.False(t, ok)
+	_, ok = WeightedChoice([]int{}, weight, r)
+	assert.False(t, ok)
+}
+
+func TestWeightedSample(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	weight := func(v int) float64 { return float64(v) }
+
+	result := WeightedSample([]int{0, 1, 2, 3}, 2, weight, r)
+	assert.Len(t, result, 2)
+	assert.Len(t, Uniq(result), 2)
+	assert.NotContains(t, result, 0)
+
+	assert.ElementsMatch(t, []int{1, 2, 3}, WeightedSample([]int{0, 1, 2, 3}, 10, weight, r))
+	assert.Equal(t, []int{}, WeightedSample([]int{1}, 0, weight, r))
+
+	firsts := 0
+	for i := 0; i < 5000; i++ {
+		if WeightedSample([]int{1, 9}, 1, weight, r)[0] == 9 {
+			firsts++
+		}
+	}
+	assert.InDelta(t, 0.9, float64(firsts)/5000, 0.03)
+}
+
+func TestReservoir(t *testing.T) {
+	s := NewReservoir[int](3, rand.New(rand.NewSource(1)))
+	s.Add(1)
+	s.Add(2)
+	assert.Equal(t, []int{1, 2}, s.Items())
+
+	for i := 3; i <= 100; i++ {
+		s.Add(i)
+	}
+	assert.Equal(t, 100, s.Count())
+	assert.Len(t, s.Items(), 3)
+	assert.Len(t, Uniq(s.Items()), 3)
+
+	// every element should have the same chance to be kept
+	hits := make([]int, 10)
+	r := rand.New(rand.NewSource(2))
+	for i := 0; i < 20000; i++ {
+		s := NewReservoir[int](2, r)
+		for j := 0; j < 10; j++ {
+			s.Add(j)
+		}
+		for _, v := range s.Items() {
+			hits[v]++
+		}
+	}
+	for _, h := range hits {
+		assert.InDelta(t, 0.2, float64(h)/20000, 0.02)
+	}
+}
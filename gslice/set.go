@@ -0,0 +1,76 @@
+package gslice
+
+// the set operations in this file treat slices as sets:
+// results never contain duplicates (the first occurrence is kept, like Uniq),
+// and elements keep the order in which they appear in the first slice, then the second.
+
+// Intersect return the elements of a which also appear in b
+func Intersect[T comparable](a, b []T) []T {
+	return IntersectBy(a, b, func(v T) T { return v })
+}
+
+// IntersectBy return the elements of a whose key also appears in b
+func IntersectBy[T any, K comparable](a, b []T, keyFunc func(T) K) []T {
+	keys := ToSetBy(b, keyFunc)
+	return UniqBy(Filter(a, func(v T) bool {
+		_, ok := keys[keyFunc(v)]
+		return ok
+	}), keyFunc)
+}
+
+// Union return the elements which appear in a or b
+func Union[T comparable](a, b []T) []T {
+	return Uniq(Concat(a, b))
+}
+
+// UnionBy return the elements whose key appears in a or b
+func UnionBy[T any, K comparable](a, b []T, keyFunc func(T) K) []T {
+	return UniqBy(Concat(a, b), keyFunc)
+}
+
+// Difference return the elements of a which do not appear in b
+func Difference[T comparable](a, b []T) []T {
+	return DifferenceBy(a, b, func(v T) T { return v })
+}
+
+// DifferenceBy return the elements of a whose key does not appear in b
+func DifferenceBy[T any, K comparable](a, b []T, keyFunc func(T) K) []T {
+	keys := ToSetBy(b, keyFunc)
+	return UniqBy(Filter(a, func(v T) bool {
+		_, ok := keys[keyFunc(v)]
+		return !ok
+	}), keyFunc)
+}
+
+// SymmetricDifference return the elements which appear in exactly one of a and b,
+// elements only in a come first, followed by elements only in b
+func SymmetricDifference[T comparable](a, b []T) []T {
+	return Concat(Difference(a, b), Difference(b, a))
+}
+
+// ToSet converts a slice into a set represented as map[T]struct{}
+func ToSet[T comparable](slice []T) map[T]struct{} {
+	return ToSetBy(slice, func(v T) T { return v })
+}
+
+// ToSetBy converts a slice into a set of keys extracted by keyFunc
+func ToSetBy[T any, K comparable](slice []T, keyFunc func(T) K) map[K]struct{} {
+	return uniqBy(slice, keyFunc, nil)
+}
+
+// uniqBy builds the set of keys of slice and calls f, if not nil, with the first element of each key,
+// it's shared by UniqBy and ToSetBy
+func uniqBy[T any, K comparable](slice []T, keyFunc func(T) K, f func(T)) map[K]struct{} {
+	seen := make(map[K]struct{}, len(slice))
+	for _, v := range slice {
+		k := keyFunc(v)
+		if _, ok := seen[k]; ok {
+			continue
+		}
+		seen[k] = struct{}{}
+		if f != nil {
+			f(v)
+		}
+	}
+	return seen
+}
@@ -0,0 +1,71 @@
+package gslice
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSetOperations(t *testing.T) {
+	a := []int{3, 1, 2, 1, 4}
+	b := []int{4, 5, 1, 5}
+
+	tests := []struct {
+		name     string
+		result   []int
+		expected []int
+	}{
+		{"Intersect", Intersect(a, b), []int{1, 4}},
+		{"Union", Union(a, b), []int{3, 1, 2, 4, 5}},
+		{"Difference", Difference(a, b), []int{3, 2}},
+		{"Difference reversed", Difference(b, a), []int{5}},
+		{"SymmetricDifference", SymmetricDifference(a, b), []int{3, 2, 5}},
+		{"Intersect empty", Intersect(a, []int{}), []int{}},
+		{"Union empty", Union([]int{}, []int{}), []int{}},
+		{"Difference empty", Difference(a, nil), []int{3, 1, 2, 4}},
+		{"SymmetricDifference equal", SymmetricDifference(a, []int{1, 2, 3, 4}), []int{}},
+	}
+	for _, tt := range tests {
+		if !reflect.DeepEqual(tt.result, tt.expected) {
+			t.Errorf("%s was incorrect, got %v, expected %v", tt.name, tt.result, tt.expected)
+		}
+	}
+}
+
+func TestSetOperationsBy(t *testing.T) {
+	oldUsers := []Person{{"Alice", 30}, {"Bob", 25}, {"Carol", 35}}
+	newUsers := []Person{{"Bob", 26}, {"Dave", 40}, {"Alice", 31}}
+	byName := func(p Person) string { return p.Name }
+
+	kept := IntersectBy(oldUsers, newUsers, byName)
+	if expected := []Person{{"Alice", 30}, {"Bob", 25}}; !reflect.DeepEqual(kept, expected) {
+		t.Errorf("IntersectBy was incorrect, got %v, expected %v", kept, expected)
+	}
+
+	removed := DifferenceBy(oldUsers, newUsers, byName)
+	if expected := []Person{{"Carol", 35}}; !reflect.DeepEqual(removed, expected) {
+		t.Errorf("DifferenceBy was incorrect, got %v, expected %v", removed, expected)
+	}
+
+	added := DifferenceBy(newUsers, oldUsers, byName)
+	if expected := []Person{{"Dave", 40}}; !reflect.DeepEqual(added, expected) {
+		t.Errorf("DifferenceBy was incorrect, got %v, expected %v", added, expected)
+	}
+
+	all := UnionBy(oldUsers, newUsers, byName)
+	if expected := []Person{{"Alice", 30}, {"Bob", 25}, {"Carol", 35}, {"Dave", 40}}; !reflect.DeepEqual(all, expected) {
+		t.Errorf("UnionBy was incorrect, got %v, expected %v", all, expected)
+	}
+}
+
+func TestToSet(t *testing.T) {
+	result := ToSet([]int{1, 2, 2, 3})
+	expected := map[int]struct{}{1: {}, 2: {}, 3: {}}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("ToSet was incorrect, got %v, expected %v", result, expected)
+	}
+
+	names := ToSetBy([]Person{{"Alice", 30}, {"Bob", 25}}, func(p Person) string { return p.Name })
+	if expected := map[string]struct{}{"Alice": {}, "Bob": {}}; !reflect.DeepEqual(names, expected) {
+		t.Errorf("ToSetBy was incorrect, got %v, expected %v", names, expected)
+	}
+}
@@ -0,0 +1,366 @@
+package gslice
+
+import (
+	"math/bits"
+)
+
+// SortFunc sorts the elements in the slice in place by less function and returns it,
+// the order of equal elements is not preserved
+//
+// it uses pattern-defeating quicksort, O(n log n) in the worst case.
+func SortFunc[T any](slice []T, less func(T, T) bool) []T {
+	pdqsort(slice, 0, len(slice), bits.Len(uint(len(slice))), less)
+	return slice
+}
+
+// SortStableFunc sorts the elements in the slice in place by less function and returns it,
+// the order of equal elements is preserved
+//
+// it uses merge sort with a buffer of len(slice) elements.
+func SortStableFunc[T any](slice []T, less func(T, T) bool) []T {
+	mergeSort(slice, less)
+	return slice
+}
+
+// lessNaN orders NaNs before every other value so that floats can be sorted consistently,
+// for other types it's the same as <
+func lessNaN[T Ordered](a, b T) bool {
+	return a < b || (a != a && b == b)
+}
+
+// mergeSort sorts data stably: insertion sort on small blocks, then bottom-up merges
+// alternating between data and a buffer
+func mergeSort[T any](data []T, less func(T, T) bool) {
+	const blockSize = 24
+
+	n := len(data)
+	for a := 0; a < n; a += blockSize {
+		b := a + blockSize
+		if b > n {
+			b = n
+		}
+		insertionSort(data, a, b, less)
+	}
+	if n <= blockSize {
+		return
+	}
+
+	src, dst := data, make([]T, n)
+	for width := blockSize; width < n; width *= 2 {
+		for a := 0; a < n; a += 2 * width {
+			m, b := a+width, a+2*width
+			if m > n {
+				m = n
+			}
+			if b > n {
+				b = n
+			}
+			merge(src[a:m], src[m:b], dst[a:b], less)
+		}
+		src, dst = dst, src
+	}
+	if &src[0] != &data[0] {
+		copy(data, src)
+	}
+}
+
+// merge merges the sorted slices left and right into dst, taking from left on ties to keep the sort stable
+func merge[T any](left, right, dst []T, less func(T, T) bool) {
+	i, j, k := 0, 0, 0
+	for i < len(left) && j < len(right) {
+		if less(right[j], left[i]) {
+			dst[k] = right[j]
+			j++
+		} else {
+			dst[k] = left[i]
+			i++
+		}
+		k++
+	}
+	k += copy(dst[k:], left[i:])
+	copy(dst[k:], right[j:])
+}
+
+func insertionSort[T any](data []T, a, b int, less func(T, T) bool) {
+	for i := a + 1; i < b; i++ {
+		for j := i; j > a && less(data[j], data[j-1]); j-- {
+			data[j], data[j-1] = data[j-1], data[j]
+		}
+	}
+}
+
+// the following is a generic port of the pattern-defeating quicksort used by the sort package of the standard library,
+// see https://github.com/orlp/pdqsort
+
+type sortedHint int
+
+const (
+	unknownHint sortedHint = iota
+	increasingHint
+	decreasingHint
+)
+
+// pdqsort sorts data[a:b], limit is the number of allowed bad pivot choices before falling back to heapsort
+func pdqsort[T any](data []T, a, b, limit int, less func(T, T) bool) {
+	const maxInsertion = 12
+
+	wasBalanced := true
+	wasPartitioned := true
+	for {
+		length := b - a
+		if length <= maxInsertion {
+			insertionSort(data, a, b, less)
+			return
+		}
+
+		// fall back to heapsort if too many bad choices were made
+		if limit == 0 {
+			heapSort(data, a, b, less)
+			return
+		}
+
+		// if the last partitioning was imbalanced, try to break patterns
+		if !wasBalanced {
+			breakPatterns(data, a, b)
+			limit--
+		}
+
+		pivot, hint := choosePivot(data, a, b, less)
+		if hint == decreasingHint {
+			reverseRange(data, a, b)
+			pivot = (b - 1) - (pivot - a)
+			hint = increasingHint
+		}
+
+		// the slice is likely already sorted
+		if wasBalanced && wasPartitioned && hint == increasingHint {
+			if partialInsertionSort(data, a, b, less) {
+				return
+			}
+		}
+
+		// data[a-1] is the pivot of an earlier partition, if it equals the new pivot
+		// all elements in data[a:b] are >= it, so put the equal ones aside and continue with the greater ones
+		if a > 0 && !less(data[a-1], data[pivot]) {
+			a = partitionEqual(data, a, b, pivot, less)
+			continue
+		}
+
+		mid, alreadyPartitioned := partition(data, a, b, pivot, less)
+		wasPartitioned = alreadyPartitioned
+
+		// recurse into the smaller side and loop on the larger one to bound the stack depth
+		leftLen, rightLen := mid-a, b-mid
+		balanceThreshold := length / 8
+		if leftLen < rightLen {
+			wasBalanced = leftLen >= balanceThreshold
+			pdqsort(data, a, mid, limit, less)
+			a = mid + 1
+		} else {
+			wasBalanced = rightLen >= balanceThreshold
+			pdqsort(data, mid+1, b, limit, less)
+			b = mid
+		}
+	}
+}
+
+// partition moves the elements less than data[pivot] before it and the others after it,
+// it returns the new position of the pivot and whether no element had to be moved
+func partition[T any](data []T, a, b, pivot int, less func(T, T) bool) (int, bool) {
+	data[a], data[pivot] = data[pivot], data[a]
+	i, j := a+1, b-1
+
+	for i <= j && less(data[i], data[a]) {
+		i++
+	}
+	for i <= j && !less(data[j], data[a]) {
+		j--
+	}
+	if i > j {
+		data[j], data[a] = data[a], data[j]
+		return j, true
+	}
+	data[i], data[j] = data[j], data[i]
+	i++
+	j--
+
+	for {
+		for i <= j && less(data[i], data[a]) {
+			i++
+		}
+		for i <= j && !less(data[j], data[a]) {
+			j--
+		}
+		if i > j {
+			break
+		}
+		data[i], data[j] = data[j], data[i]
+		i++
+		j--
+	}
+	data[j], data[a] = data[a], data[j]
+	return j, false
+}
+
+// partitionEqual moves the elements equal to data[pivot] to the front and returns the index of the first greater one,
+// all elements of data[a:b] must be >= data[pivot]
+func partitionEqual[T any](data []T, a, b, pivot int, less func(T, T) bool) int {
+	data[a], data[pivot] = data[pivot], data[a]
+	i, j := a+1, b-1
+
+	for {
+		for i <= j && !less(data[a], data[i]) {
+			i++
+		}
+		for i <= j && less(data[a], data[j]) {
+			j--
+		}
+		if i > j {
+			break
+		}
+		data[i], data[j] = data[j], data[i]
+		i++
+		j--
+	}
+	return i
+}
+
+// partialInsertionSort sorts data[a:b] if only a few elements are out of place and reports whether it succeeded
+func partialInsertionSort[T any](data []T, a, b int, less func(T, T) bool) bool {
+	const (
+		maxSteps         = 5
+		shortestShifting = 50
+	)
+
+	i := a + 1
+	for step := 0; step < maxSteps; step++ {
+		for i < b && !less(data[i], data[i-1]) {
+			i++
+		}
+		if i == b {
+			return true
+		}
+		if b-a < shortestShifting {
+			return false
+		}
+
+		data[i], data[i-1] = data[i-1], data[i]
+
+		// shift the smaller one to the left
+		if i-a >= 2 {
+			for j := i - 1; j >= 1; j-- {
+				if !less(data[j], data[j-1]) {
+					break
+				}
+				data[j], data[j-1] = data[j-1], data[j]
+			}
+		}
+		// shift the greater one to the right
+		if b-i >= 2 {
+			for j := i + 1; j < b; j++ {
+				if !less(data[j], data[j-1]) {
+					break
+				}
+				data[j], data[j-1] = data[j-1], data[j]
+			}
+		}
+	}
+	return false
+}
+
+// breakPatterns scatters some elements around to break patterns which cause imbalanced partitions
+func breakPatterns[T any](data []T, a, b int) {
+	length := b - a
+	if length < 8 {
+		return
+	}
+
+	random := xorshift(length)
+	modulus := uint(1) << bits.Len(uint(length))
+	idx := a + (length/4)*2 - 1
+	for i := 0; i < 3; i++ {
+		other := int(uint(random.next()) & (modulus - 1))
+		if other >= length {
+			other -= length
+		}
+		data[idx-1+i], data[a+other] = data[a+other], data[idx-1+i]
+	}
+}
+
+type xorshift uint64
+
+func (r *xorshift) next() uint64 {
+	*r ^= *r << 13
+	*r ^= *r >> 7
+	*r ^= *r << 17
+	return uint64(*r)
+}
+
+// choosePivot chooses a pivot in data[a:b] with median of three, or Tukey's ninther for long slices,
+// the hint tells whether the sampled elements looked sorted
+func choosePivot[T any](data []T, a, b int, less func(T, T) bool) (int, sortedHint) {
+	const (
+		shortestNinther = 50
+		maxSwaps        = 4 * 3
+	)
+
+	l := b - a
+	swaps := 0
+	i := a + l/4*1
+	j := a + l/4*2
+	k := a + l/4*3
+
+	if l >= 8 {
+		if l >= shortestNinther {
+			i = medianAdjacent(data, i, &swaps, less)
+			j = medianAdjacent(data, j, &swaps, less)
+			k = medianAdjacent(data, k, &swaps, less)
+		}
+		j = median(data, i, j, k, &swaps, less)
+	}
+
+	switch swaps {
+	case 0:
+		return j, increasingHint
+	case maxSwaps:
+		return j, decreasingHint
+	default:
+		return j, unknownHint
+	}
+}
+
+func order2[T any](data []T, a, b int, swaps *int, less func(T, T) bool) (int, int) {
+	if less(data[b], data[a]) {
+		*swaps++
+		return b, a
+	}
+	return a, b
+}
+
+func median[T any](data []T, a, b, c int, swaps *int, less func(T, T) bool) int {
+	a, b = order2(data, a, b, swaps, less)
+	b, c = order2(data, b, c, swaps, less)
+	_, b = order2(data, a, b, swaps, less)
+	return b
+}
+
+func medianAdjacent[T any](data []T, a int, swaps *int, less func(T, T) bool) int {
+	return median(data, a-1, a, a+1, swaps, less)
+}
+
+func reverseRange[T any](data []T, a, b int) {
+	for i, j := a, b-1; i < j; i, j = i+1, j-1 {
+		data[i], data[j] = data[j], data[i]
+	}
+}
+
+func heapSort[T any](data []T, a, b int, less func(T, T) bool) {
+	h := data[a:b]
+	for i := (len(h) - 1) / 2; i >= 0; i-- {
+		heapDown(h, i, func(x, y T) bool { return less(y, x) })
+	}
+	for n := len(h) - 1; n > 0; n-- {
+		h[0], h[n] = h[n], h[0]
+		heapDown(h[:n], 0, func(x, y T) bool { return less(y, x) })
+	}
+}
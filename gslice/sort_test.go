@@ -0,0 +1,206 @@
+package gslice
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// sortInputs returns inputs of length n with the patterns that matter for quicksort and radix sort
+func sortInputs(n int, r *rand.Rand) map[string][]int {
+	inputs := map[string][]int{
+		"random":   make([]int, n),
+		"few":      make([]int, n),
+		"sorted":   make([]int, n),
+		"reversed": make([]int, n),
+		"sawtooth": make([]int, n),
+		"negative": make([]int, n),
+	}
+	for i := 0; i < n; i++ {
+		inputs["random"][i] = r.Int()
+		inputs["few"][i] = r.Intn(4)
+		inputs["sorted"][i] = i
+		inputs["reversed"][i] = n - i
+		inputs["sawtooth"][i] = i % 17
+		inputs["negative"][i] = r.Intn(2000) - 1000
+	}
+	return inputs
+}
+
+func TestSortMatchesStdlib(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 2, 13, 50, 255, 256, 1000, 5000} {
+		for name, input := range sortInputs(n, r) {
+			expected := append([]int{}, input...)
+			sort.Ints(expected)
+
+			assert.Equal(t, expected, Sort(append([]int{}, input...)), "Sort %s/%d", name, n)
+			assert.Equal(t, expected, SortFunc(append([]int{}, input...), lessOrdered[int]), "SortFunc %s/%d", name, n)
+			assert.Equal(t, expected, SortStableFunc(append([]int{}, input...), lessOrdered[int]), "SortStableFunc %s/%d", name, n)
+			assert.Equal(t, expected, OrderBy(input, lessOrdered[int]), "OrderBy %s/%d", name, n)
+		}
+	}
+}
+
+func TestSortIntegerKinds(t *testing.T) {
+	r := rand.New(rand.NewSource(2))
+	n := 1000
+
+	int8s := make([]int8, n)
+	int32s := make([]int32, n)
+	uint16s := make([]uint16, n)
+	uint64s := make([]uint64, n)
+	cents := make([]Cents, n)
+	for i := 0; i < n; i++ {
+		int8s[i] = int8(r.Intn(256) - 128)
+		int32s[i] = r.Int31() - math.MaxInt32/2
+		uint16s[i] = uint16(r.Intn(math.MaxUint16 + 1))
+		uint64s[i] = r.Uint64()
+		cents[i] = Cents(r.Int63() - math.MaxInt64/2)
+	}
+
+	assert.True(t, IsSorted(Sort(int8s)))
+	assert.True(t, IsSorted(Sort(int32s)))
+	assert.True(t, IsSorted(Sort(uint16s)))
+	assert.True(t, IsSorted(Sort(uint64s)))
+	assert.True(t, IsSorted(Sort(cents)))
+
+	extremes := []int64{math.MaxInt64, math.MinInt64, 0, -1, 1}
+	for len(extremes) < radixThreshold {
+		extremes = append(extremes, extremes[:5]...)
+	}
+	assert.True(t, IsSorted(Sort(extremes)))
+	assert.Equal(t, int64(math.MinInt64), extremes[0])
+	assert.Equal(t, int64(math.MaxInt64), extremes[len(extremes)-1])
+}
+
+func TestSortStrings(t *testing.T) {
+	r := rand.New(rand.NewSource(3))
+	for _, maxLen := range []int{4, radixMaxStringLen, 40} {
+		input := make([]UserID, 1000)
+		for i := range input {
+			b := make([]byte, r.Intn(maxLen+1))
+			for j := range b {
+				b[j] = byte('a' + r.Intn(3))
+			}
+			input[i] = UserID(b)
+		}
+		input[0] = ""
+
+		expected := append([]UserID{}, input...)
+		sort.Slice(expected, func(i, j int) bool { return expected[i] < expected[j] })
+		assert.Equal(t, expected, Sort(input), "max length %d", maxLen)
+	}
+}
+
+func TestSortFloats(t *testing.T) {
+	input := []float64{3, math.NaN(), -1, math.Inf(1), 2, math.NaN(), math.Inf(-1), 0}
+	result := Sort(input)
+
+	assert.True(t, math.IsNaN(result[0]))
+	assert.True(t, math.IsNaN(result[1]))
+	assert.Equal(t, []float64{math.Inf(-1), -1, 0, 2, 3, math.Inf(1)}, result[2:])
+
+	r := rand.New(rand.NewSource(4))
+	scores := make([]Score, 2000)
+	for i := range scores {
+		scores[i] = Score(r.NormFloat64())
+	}
+	assert.True(t, IsSorted(Sort(scores)))
+}
+
+func TestSortStable(t *testing.T) {
+	r := rand.New(rand.NewSource(5))
+	people := make([]Person, 500)
+	for i := range people {
+		people[i] = Person{Name: strconv.Itoa(i), Age: r.Intn(10)}
+	}
+	byAge := func(a, b Person) bool { return a.Age < b.Age }
+
+	expected := append([]Person{}, people...)
+	sort.SliceStable(expected, func(i, j int) bool { return expected[i].Age < expected[j].Age })
+
+	assert.Equal(t, expected, OrderBy(people, byAge))
+	assert.Equal(t, expected, SortStableFunc(append([]Person{}, people...), byAge))
+
+	unstable := SortFunc(append([]Person{}, people...), byAge)
+	assert.True(t, IsSortedBy(unstable, byAge))
+	assert.ElementsMatch(t, people, unstable)
+}
+
+func TestSortFuncInconsistentLess(t *testing.T) {
+	// an inconsistent less function must not make the sort loop forever or panic
+	r := rand.New(rand.NewSource(6))
+	input := sortInputs(1000, r)["random"]
+	result := SortFunc(input, func(a, b int) bool { return r.Intn(2) == 0 })
+	assert.Len(t, result, 1000)
+}
+
+func benchmarkInts(n int) []int {
+	r := rand.New(rand.NewSource(1))
+	input := make([]int, n)
+	for i := range input {
+		input[i] = r.Int()
+	}
+	return input
+}
+
+func benchmarkStrings(n int) []string {
+	r := rand.New(rand.NewSource(1))
+	input := make([]string, n)
+	for i := range input {
+		input[i] = strconv.FormatUint(r.Uint64()%1e12, 36)
+	}
+	return input
+}
+
+func benchmarkSort[T any](b *testing.B, input []T, sortFunc func([]T)) {
+	data := make([]T, len(input))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		copy(data, input)
+		b.StartTimer()
+		sortFunc(data)
+	}
+}
+
+// sortSliceStable is the implementation of Sort before the generic sort engine
+func sortSliceStable[T Ordered](slice []T) {
+	sort.SliceStable(slice, func(i, j int) bool { return slice[i] < slice[j] })
+}
+
+func BenchmarkSortInts(b *testing.B) {
+	input := benchmarkInts(1 << 20)
+	b.Run("SliceStable", func(b *testing.B) { benchmarkSort(b, input, sortSliceStable[int]) })
+	b.Run("Sort", func(b *testing.B) { benchmarkSort(b, input, func(s []int) { Sort(s) }) })
+	b.Run("SortFunc", func(b *testing.B) {
+		benchmarkSort(b, input, func(s []int) { SortFunc(s, lessOrdered[int]) })
+	})
+}
+
+func BenchmarkSortStrings(b *testing.B) {
+	input := benchmarkStrings(1 << 18)
+	b.Run("SliceStable", func(b *testing.B) { benchmarkSort(b, input, sortSliceStable[string]) })
+	b.Run("Sort", func(b *testing.B) { benchmarkSort(b, input, func(s []string) { Sort(s) }) })
+}
+
+func BenchmarkOrderBy(b *testing.B) {
+	input := benchmarkInts(1 << 18)
+	less := func(a, b int) bool { return a < b }
+	b.Run("SliceStable", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			result := append([]int{}, input...)
+			sort.SliceStable(result, func(i, j int) bool { return less(result[i], result[j]) })
+		}
+	})
+	b.Run("OrderBy", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			OrderBy(input, less)
+		}
+	})
+}
@@ -0,0 +1,115 @@
+package gslice
+
+import (
+	"sort"
+)
+
+// the functions in this file expect the input slice to be sorted in ascending order
+// (by the natural order for Ordered types, or by less for the By variants),
+// the result is undefined if it is not.
+
+func lessOrdered[T Ordered](a, b T) bool {
+	return a < b
+}
+
+// IsSorted return true if the slice is sorted in ascending order
+func IsSorted[T Ordered](slice []T) bool {
+	return IsSortedBy(slice, lessOrdered[T])
+}
+
+// IsSortedBy return true if the slice is sorted by less function
+func IsSortedBy[T any](slice []T, less func(T, T) bool) bool {
+	for i := 1; i < len(slice); i++ {
+		if less(slice[i], slice[i-1]) {
+			return false
+		}
+	}
+	return true
+}
+
+// LowerBound return the index of the first element which is not less than target,
+// or len(slice) if there is no such element
+func LowerBound[T Ordered](slice []T, target T) int {
+	return LowerBoundBy(slice, target, lessOrdered[T])
+}
+
+// LowerBoundBy is like LowerBound but uses less function to compare elements
+func LowerBoundBy[T any](slice []T, target T, less func(T, T) bool) int {
+	return sort.Search(len(slice), func(i int) bool {
+		return !less(slice[i], target)
+	})
+}
+
+// UpperBound return the index of the first element which is greater than target,
+// or len(slice) if there is no such element
+func UpperBound[T Ordered](slice []T, target T) int {
+	return UpperBoundBy(slice, target, lessOrdered[T])
+}
+
+// UpperBoundBy is like UpperBound but uses less function to compare elements
+func UpperBoundBy[T any](slice []T, target T, less func(T, T) bool) int {
+	return sort.Search(len(slice), func(i int) bool {
+		return less(target, slice[i])
+	})
+}
+
+// BinarySearch searches target in a sorted slice and return the index of the first equal element and true,
+// or the index where target would be inserted and false if it's not found
+func BinarySearch[T Ordered](slice []T, target T) (int, bool) {
+	return BinarySearchBy(slice, target, lessOrdered[T])
+}
+
+// BinarySearchBy is like BinarySearch but uses less function to compare elements,
+// two elements are considered equal if neither is less than the other
+func BinarySearchBy[T any](slice []T, target T, less func(T, T) bool) (int, bool) {
+	i := LowerBoundBy(slice, target, less)
+	return i, i < len(slice) && !less(target, slice[i])
+}
+
+// InsertSorted inserts v into a sorted slice after any equal elements and returns a new slice
+func InsertSorted[T Ordered](slice []T, v T) []T {
+	return InsertSortedBy(slice, v, lessOrdered[T])
+}
+
+// InsertSortedBy is like InsertSorted but uses less function to compare elements
+func InsertSortedBy[T any](slice []T, v T, less func(T, T) bool) []T {
+	i := UpperBoundBy(slice, v, less)
+	result := make([]T, len(slice)+1)
+	copy(result, slice[:i])
+	result[i] = v
+	copy(result[i+1:], slice[i:])
+	return result
+}
+
+// RemoveSorted removes all elements equal to v from a sorted slice and returns a new slice
+func RemoveSorted[T Ordered](slice []T, v T) []T {
+	return RemoveSortedBy(slice, v, lessOrdered[T])
+}
+
+// RemoveSortedBy is like RemoveSorted but uses less function to compare elements
+func RemoveSortedBy[T any](slice []T, v T, less func(T, T) bool) []T {
+	lo := LowerBoundBy(slice, v, less)
+	hi := lo + UpperBoundBy(slice[lo:], v, less)
+	result := make([]T, 0, len(slice)-(hi-lo))
+	result = append(result, slice[:lo]...)
+	return append(result, slice[hi:]...)
+}
+
+// SortedUniq remove duplicate elements from a sorted slice and returns a new slice,
+// it's faster than Uniq since only adjacent elements are compared
+func SortedUniq[T Ordered](slice []T) []T {
+	return SortedUniqBy(slice, lessOrdered[T])
+}
+
+// SortedUniqBy is like SortedUniq but uses less function to compare elements,
+// two elements are considered equal if neither is less than the other
+func SortedUniqBy[T any](slice []T, less func(T, T) bool) []T {
+	result := make([]T, 0)
+	for i, v := range slice {
+		if i > 0 && !less(slice[i-1], v) {
+			continue
+		}
+		result = append(result, v)
+	}
+	return result
+}
@@ -0,0 +1,118 @@
+package gslice
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsSorted(t *testing.T) {
+	assert.True(t, IsSorted([]int{}))
+	assert.True(t, IsSorted([]int{1}))
+	assert.True(t, IsSorted([]int{1, 2, 2, 3}))
+	assert.False(t, IsSorted([]int{1, 3, 2}))
+	assert.True(t, IsSorted([]string{"a", "b", "c"}))
+
+	desc := func(a, b int) bool { return a > b }
+	assert.True(t, IsSortedBy([]int{3, 2, 2, 1}, desc))
+	assert.False(t, IsSortedBy([]int{1, 2}, desc))
+}
+
+func TestLowerUpperBound(t *testing.T) {
+	slice := []int{1, 2, 2, 2, 4, 5}
+	tests := []struct {
+		target int
+		lower  int
+		upper  int
+	}{
+		{0, 0, 0},
+		{1, 0, 1},
+		{2, 1, 4},
+		{3, 4, 4},
+		{5, 5, 6},
+		{6, 6, 6},
+	}
+	for _, tt := range tests {
+		if got := LowerBound(slice, tt.target); got != tt.lower {
+			t.Errorf("LowerBound(%d) = %d, expected %d", tt.target, got, tt.lower)
+		}
+		if got := UpperBound(slice, tt.target); got != tt.upper {
+			t.Errorf("UpperBound(%d) = %d, expected %d", tt.target, got, tt.upper)
+		}
+	}
+
+	assert.Equal(t, 0, LowerBound([]int{}, 1))
+	assert.Equal(t, 0, UpperBound([]int{}, 1))
+}
+
+func TestBinarySearch(t *testing.T) {
+	slice := []int{1, 3, 3, 5, 7}
+
+	i, found := BinarySearch(slice, 3)
+	assert.True(t, found)
+	assert.Equal(t, 1, i)
+
+	i, found = BinarySearch(slice, 4)
+	assert.False(t, found)
+	assert.Equal(t, 3, i)
+
+	i, found = BinarySearch(slice, 8)
+	assert.False(t, found)
+	assert.Equal(t, 5, i)
+
+	i, found = BinarySearch([]int{}, 1)
+	assert.False(t, found)
+	assert.Equal(t, 0, i)
+}
+
+func TestBinarySearchBy(t *testing.T) {
+	people := []Person{{"Bob", 25}, {"Alice", 30}, {"Carol", 35}}
+	byAge := func(a, b Person) bool { return a.Age < b.Age }
+
+	i, found := BinarySearchBy(people, Person{Age: 30}, byAge)
+	assert.True(t, found)
+	assert.Equal(t, "Alice", people[i].Name)
+
+	_, found = BinarySearchBy(people, Person{Age: 31}, byAge)
+	assert.False(t, found)
+}
+
+func TestInsertSorted(t *testing.T) {
+	slice := []int{1, 3, 5}
+	result := InsertSorted(slice, 4)
+	expected := []int{1, 3, 4, 5}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+	if !reflect.DeepEqual(slice, []int{1, 3, 5}) {
+		t.Errorf("InsertSorted should not modify the input, got %v", slice)
+	}
+
+	assert.Equal(t, []int{0, 1, 3, 5}, InsertSorted(slice, 0))
+	assert.Equal(t, []int{1, 3, 5, 6}, InsertSorted(slice, 6))
+	assert.Equal(t, []int{1}, InsertSorted([]int{}, 1))
+
+	// equal elements are inserted after the existing ones to keep the order stable
+	people := []Person{{"Alice", 30}}
+	byAge := func(a, b Person) bool { return a.Age < b.Age }
+	assert.Equal(t, []Person{{"Alice", 30}, {"Bob", 30}}, InsertSortedBy(people, Person{"Bob", 30}, byAge))
+}
+
+func TestRemoveSorted(t *testing.T) {
+	slice := []int{1, 2, 2, 3}
+	assert.Equal(t, []int{1, 3}, RemoveSorted(slice, 2))
+	assert.Equal(t, []int{1, 2, 2, 3}, RemoveSorted(slice, 4))
+	assert.Equal(t, []int{1, 2, 2, 3}, slice)
+	assert.Equal(t, []int{}, RemoveSorted([]int{}, 1))
+}
+
+func TestSortedUniq(t *testing.T) {
+	assert.Equal(t, []int{1, 2, 3}, SortedUniq([]int{1, 1, 2, 3, 3, 3}))
+	assert.Equal(t, []int{}, SortedUniq([]int{}))
+	assert.Equal(t, []string{"a", "b"}, SortedUniq([]string{"a", "b", "b"}))
+
+	people := []Person{{"Bob", 25}, {"Alice", 30}, {"Carol", 30}}
+	byAge := func(a, b Person) bool { return a.Age < b.Age }
+	assert.Equal(t, []Person{{"Bob", 25}, {"Alice", 30}}, SortedUniqBy(people, byAge))
+}
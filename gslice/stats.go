@@ -0,0 +1,258 @@
+package gslice
+
+import (
+	"math"
+	"sort"
+)
+
+// PercentileMethod decides how Percentile interpolates when the requested rank falls between two elements
+type PercentileMethod int
+
+const (
+	// PercentileLinear interpolates linearly between the two closest elements
+	PercentileLinear PercentileMethod = iota
+	// PercentileLower takes the smaller of the two closest elements
+	PercentileLower
+	// PercentileHigher takes the larger of the two closest elements
+	PercentileHigher
+	// PercentileNearest takes the closest element, rounding half away from zero
+	PercentileNearest
+	// PercentileMidpoint takes the mean of the two closest elements
+	PercentileMidpoint
+)
+
+// VarianceKind decides whether Variance and StdDev treat the slice as a whole population or as a sample
+type VarianceKind int
+
+const (
+	// VariancePopulation divides the sum of squared deviations by n
+	VariancePopulation VarianceKind = iota
+	// VarianceSample divides the sum of squared deviations by n-1 (Bessel's correction)
+	VarianceSample
+)
+
+// Bin is a bucket of a histogram, it counts values in [Lower, Upper),
+// the last bin of a histogram also includes its Upper bound
+type Bin struct {
+	Lower float64
+	Upper float64
+	Count int
+}
+
+func identity[T any](v T) T {
+	return v
+}
+
+// Mean return the arithmetic mean of slice, or 0 if slice is empty
+//
+// a NaN value makes the mean NaN, unlike Median, Percentile and Histogram which ignore NaN values.
+func Mean[T Number](slice []T) float64 {
+	return MeanBy(slice, identity[T])
+}
+
+// MeanBy return the arithmetic mean of the values extracted by f, or 0 if slice is empty
+func MeanBy[T any, E Number](slice []T, f func(T) E) float64 {
+	if len(slice) == 0 {
+		return 0
+	}
+
+	var sum float64
+	for _, v := range slice {
+		sum += float64(f(v))
+	}
+	return sum / float64(len(slice))
+}
+
+// Median return the median of slice, NaN values are ignored, 0 is returned if no other value is left
+func Median[T Number](slice []T) float64 {
+	return MedianBy(slice, identity[T])
+}
+
+// MedianBy return the median of the values extracted by f, NaN values are ignored
+func MedianBy[T any, E Number](slice []T, f func(T) E) float64 {
+	return PercentileBy(slice, f, 50, PercentileLinear)
+}
+
+// Percentile return the p-th percentile (0 <= p <= 100) of slice, NaN values are ignored
+// and 0 is returned if no other value is left
+//
+// p is clamped to [0, 100], NaN is returned if p is NaN.
+func Percentile[T Number](slice []T, p float64, method PercentileMethod) float64 {
+	return PercentileBy(slice, identity[T], p, method)
+}
+
+// PercentileBy return the p-th percentile (0 <= p <= 100) of the values extracted by f, NaN values are ignored
+func PercentileBy[T any, E Number](slice []T, f func(T) E, p float64, method PercentileMethod) float64 {
+	if math.IsNaN(p) {
+		return math.NaN()
+	}
+	values := sortedFloats(slice, f)
+	if len(values) == 0 {
+		return 0
+	}
+
+	p = math.Max(0, math.Min(100, p))
+	rank := p / 100 * float64(len(values)-1)
+	lo := int(math.Floor(rank))
+	hi := int(math.Ceil(rank))
+
+	switch method {
+	case PercentileLower:
+		return values[lo]
+	case PercentileHigher:
+		return values[hi]
+	case PercentileNearest:
+		return values[int(math.Round(rank))]
+	case PercentileMidpoint:
+		return (values[lo] + values[hi]) / 2
+	default:
+		return values[lo] + (values[hi]-values[lo])*(rank-float64(lo))
+	}
+}
+
+// Variance return the variance of slice,
+// 0 is returned if slice is empty, or if it has only one element and kind is VarianceSample
+//
+// like Mean, a NaN value makes the variance NaN.
+func Variance[T Number](slice []T, kind VarianceKind) float64 {
+	return VarianceBy(slice, identity[T], kind)
+}
+
+// VarianceBy return the variance of the values extracted by f
+func VarianceBy[T any, E Number](slice []T, f func(T) E, kind VarianceKind) float64 {
+	n := len(slice)
+	if kind == VarianceSample {
+		n--
+	}
+	if n <= 0 {
+		return 0
+	}
+
+	mean := MeanBy(slice, f)
+	var sum float64
+	for _, v := range slice {
+		d := float64(f(v)) - mean
+		sum += d * d
+	}
+	return sum / float64(n)
+}
+
+// StdDev return the standard deviation of slice
+func StdDev[T Number](slice []T, kind VarianceKind) float64 {
+	return math.Sqrt(Variance(slice, kind))
+}
+
+// StdDevBy return the standard deviation of the values extracted by f
+func StdDevBy[T any, E Number](slice []T, f func(T) E, kind VarianceKind) float64 {
+	return math.Sqrt(VarianceBy(slice, f, kind))
+}
+
+// Histogram divides the range [min, max] of slice into 'bins' bins of equal width and counts the values in each bin
+//
+// if all values are equal, the range is widened to [v-0.5, v+0.5].
+// NaN and ±Inf values are ignored, an empty histogram is returned if no finite value is left.
+func Histogram[T Number](slice []T, bins int) []Bin {
+	return HistogramBy(slice, identity[T], bins)
+}
+
+// HistogramBy is like Histogram but counts the values extracted by f
+func HistogramBy[T any, E Number](slice []T, f func(T) E, bins int) []Bin {
+	if bins <= 0 {
+		return []Bin{}
+	}
+
+	values := make([]float64, 0, len(slice))
+	for _, v := range slice {
+		if x := float64(f(v)); !math.IsNaN(x) && !math.IsInf(x, 0) {
+			values = append(values, x)
+		}
+	}
+	if len(values) == 0 {
+		return []Bin{}
+	}
+
+	lo, hi := values[0], values[0]
+	for _, x := range values {
+		lo = math.Min(lo, x)
+		hi = math.Max(hi, x)
+	}
+	if lo == hi {
+		lo, hi = lo-0.5, hi+0.5
+	}
+
+	// hi - lo can overflow for finite values of opposite signs, so the bounds are computed
+	// as weighted averages of lo and hi and the positions relative to half the range
+	result := make([]Bin, bins)
+	for i := range result {
+		result[i].Lower = lerp(lo, hi, float64(i)/float64(bins))
+		result[i].Upper = lerp(lo, hi, float64(i+1)/float64(bins))
+	}
+	result[bins-1].Upper = hi
+
+	halfRange := hi/2 - lo/2
+	for _, x := range values {
+		i := 0
+		// the position is NaN only if halfRange underflows to 0, put everything in the first bin then
+		if pos := (x/2 - lo/2) / halfRange; pos > 0 {
+			i = int(pos * float64(bins))
+		}
+		if i >= bins {
+			i = bins - 1
+		}
+		result[i].Count++
+	}
+	return result
+}
+
+// lerp return the point at t in [0, 1] between lo and hi without overflowing
+func lerp(lo, hi, t float64) float64 {
+	return lo*(1-t) + hi*t
+}
+
+// HistogramEdges counts the values of slice in the bins defined by edges,
+// edges must be sorted in ascending order and bin i is [edges[i], edges[i+1])
+//
+// values outside [edges[0], edges[len(edges)-1]] and NaN values are ignored.
+func HistogramEdges[T Number](slice []T, edges []float64) []Bin {
+	return HistogramEdgesBy(slice, identity[T], edges)
+}
+
+// HistogramEdgesBy is like HistogramEdges but counts the values extracted by f
+func HistogramEdgesBy[T any, E Number](slice []T, f func(T) E, edges []float64) []Bin {
+	if len(edges) < 2 {
+		return []Bin{}
+	}
+
+	result := make([]Bin, len(edges)-1)
+	for i := range result {
+		result[i].Lower = edges[i]
+		result[i].Upper = edges[i+1]
+	}
+
+	last := edges[len(edges)-1]
+	for _, v := range slice {
+		x := float64(f(v))
+		if math.IsNaN(x) || x < edges[0] || x > last {
+			continue
+		}
+		if x == last {
+			result[len(result)-1].Count++
+			continue
+		}
+		i := sort.Search(len(edges), func(i int) bool { return edges[i] > x })
+		result[i-1].Count++
+	}
+	return result
+}
+
+// sortedFloats return the values extracted by f without NaNs in ascending order
+func sortedFloats[T any, E Number](slice []T, f func(T) E) []float64 {
+	result := make([]float64, 0, len(slice))
+	for _, v := range slice {
+		if x := float64(f(v)); !math.IsNaN(x) {
+			result = append(result, x)
+		}
+	}
+	sort.Float64s(result)
+	return result
+}
@@ -0,0 +1,152 @@
+package gslice
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMean(t *testing.T) {
+	assert.Equal(t, 2.5, Mean([]int{1, 2, 3, 4}))
+	assert.Equal(t, 0.0, Mean([]int{}))
+	assert.InDelta(t, 0.2, Mean([]float32{0.1, 0.3}), 1e-6)
+
+	people := []Person{{"Alice", 30}, {"Bob", 25}}
+	assert.Equal(t, 27.5, MeanBy(people, func(p Person) int { return p.Age }))
+}
+
+func TestMedian(t *testing.T) {
+	assert.Equal(t, 3.0, Median([]int{5, 1, 3}))
+	assert.Equal(t, 2.5, Median([]int{4, 1, 3, 2}))
+	assert.Equal(t, 0.0, Median([]int{}))
+
+	input := []int{3, 1, 2}
+	Median(input)
+	assert.Equal(t, []int{3, 1, 2}, input, "Median should not modify the input")
+}
+
+func TestMode(t *testing.T) {
+	assert.Equal(t, 2, Mode([]int{1, 2, 2, 3}))
+	assert.Equal(t, 3, Mode([]int{3, 1, 1, 3}), "ties are broken by first occurrence")
+	assert.Equal(t, 0, Mode([]int{}))
+}
+
+func TestPercentile(t *testing.T) {
+	input := []int{1, 2, 3, 4}
+	tests := []struct {
+		p        float64
+		method   PercentileMethod
+		expected float64
+	}{
+		{50, PercentileLinear, 2.5},
+		{50, PercentileLower, 2},
+		{50, PercentileHigher, 3},
+		{50, PercentileNearest, 3},
+		{50, PercentileMidpoint, 2.5},
+		{40, PercentileNearest, 2},
+		{0, PercentileLinear, 1},
+		{100, PercentileLinear, 4},
+		{-10, PercentileLinear, 1},
+		{200, PercentileLinear, 4},
+		{90, PercentileLinear, 3.7},
+	}
+	for _, tt := range tests {
+		if got := Percentile(input, tt.p, tt.method); math.Abs(got-tt.expected) > 1e-9 {
+			t.Errorf("Percentile(%v, %v) = %v, expected %v", tt.p, tt.method, got, tt.expected)
+		}
+	}
+
+	assert.Equal(t, 0.0, Percentile([]int{}, 50, PercentileLinear))
+
+	latencies := []Person{{"a", 10}, {"b", 30}, {"c", 20}}
+	assert.Equal(t, 29.0, PercentileBy(latencies, func(p Person) int { return p.Age }, 95, PercentileLinear))
+}
+
+func TestPercentileNaN(t *testing.T) {
+	input := []float64{math.NaN(), 1, 2, math.NaN(), 3}
+	assert.Equal(t, 2.0, Median(input))
+	assert.Equal(t, 1.0, Percentile(input, 0, PercentileLinear))
+	assert.Equal(t, 3.0, Percentile(input, 100, PercentileLinear))
+	assert.Equal(t, 0.0, Median([]float64{math.NaN()}))
+
+	assert.True(t, math.IsNaN(Percentile([]int{1, 2, 3}, math.NaN(), PercentileLinear)))
+	assert.Equal(t, 3.0, Percentile([]int{1, 2, 3}, math.Inf(1), PercentileNearest))
+	assert.Equal(t, 1.0, Percentile([]int{1, 2, 3}, -5, PercentileNearest))
+
+	// unlike the order statistics, Mean and Variance propagate NaN
+	assert.True(t, math.IsNaN(Mean(input)))
+	assert.True(t, math.IsNaN(Variance(input, VariancePopulation)))
+}
+
+func TestVariance(t *testing.T) {
+	input := []int{2, 4, 4, 4, 5, 5, 7, 9}
+	assert.Equal(t, 4.0, Variance(input, VariancePopulation))
+	assert.Equal(t, 2.0, StdDev(input, VariancePopulation))
+	assert.InDelta(t, 32.0/7, Variance(input, VarianceSample), 1e-9)
+	assert.InDelta(t, math.Sqrt(32.0/7), StdDev(input, VarianceSample), 1e-9)
+
+	assert.Equal(t, 0.0, Variance([]int{}, VariancePopulation))
+	assert.Equal(t, 0.0, Variance([]int{1}, VarianceSample))
+
+	people := []Person{{"Alice", 30}, {"Bob", 20}}
+	assert.Equal(t, 25.0, VarianceBy(people, func(p Person) int { return p.Age }, VariancePopulation))
+	assert.Equal(t, 5.0, StdDevBy(people, func(p Person) int { return p.Age }, VariancePopulation))
+}
+
+func TestHistogram(t *testing.T) {
+	result := Histogram([]int{0, 1, 2, 3, 4, 10}, 2)
+	expected := []Bin{{0, 5, 5}, {5, 10, 1}}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+
+	result = Histogram([]int{3, 3}, 1)
+	expected = []Bin{{2.5, 3.5, 2}}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+
+	assert.Equal(t, []Bin{}, Histogram([]int{}, 3))
+	assert.Equal(t, []Bin{}, Histogram([]int{1}, 0))
+}
+
+func TestHistogramNonFinite(t *testing.T) {
+	input := []float64{math.NaN(), 0, math.Inf(1), 4, math.Inf(-1), 10}
+	assert.Equal(t, []Bin{{0, 5, 2}, {5, 10, 1}}, Histogram(input, 2))
+	assert.Equal(t, []Bin{}, Histogram([]float64{math.NaN(), math.Inf(1)}, 2))
+
+	assert.Equal(t, []Bin{{0, 10, 3}}, HistogramEdges(input, []float64{0, 10}))
+}
+
+func TestHistogramExtremes(t *testing.T) {
+	result := Histogram([]float64{-math.MaxFloat64, math.MaxFloat64, 0}, 2)
+	assert.Equal(t, []Bin{{-math.MaxFloat64, 0, 1}, {0, math.MaxFloat64, 2}}, result)
+
+	result = Histogram([]float64{-math.MaxFloat64, math.MaxFloat64}, 1)
+	assert.Equal(t, []Bin{{-math.MaxFloat64, math.MaxFloat64, 2}}, result)
+
+	result = Histogram([]float64{math.MaxFloat64, math.MaxFloat64}, 3)
+	assert.Equal(t, 2, result[0].Count+result[1].Count+result[2].Count)
+
+	result = Histogram([]float64{math.SmallestNonzeroFloat64, 0}, 2)
+	assert.Equal(t, 2, result[0].Count+result[1].Count)
+}
+
+func TestHistogramBy(t *testing.T) {
+	people := []Person{{"Alice", 30}, {"Bob", 20}, {"Carol", 25}}
+	age := func(p Person) int { return p.Age }
+	assert.Equal(t, []Bin{{20, 25, 1}, {25, 30, 2}}, HistogramBy(people, age, 2))
+	assert.Equal(t, []Bin{{0, 25, 1}, {25, 50, 2}}, HistogramEdgesBy(people, age, []float64{0, 25, 50}))
+}
+
+func TestHistogramEdges(t *testing.T) {
+	result := HistogramEdges([]float64{-1, 0, 5, 10, 50, 100, 101}, []float64{0, 10, 100})
+	expected := []Bin{{0, 10, 2}, {10, 100, 3}}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+
+	assert.Equal(t, []Bin{}, HistogramEdges([]int{1}, []float64{0}))
+}
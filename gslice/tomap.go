@@ -0,0 +1,93 @@
+package gslice
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrDuplicateKey is wrapped by the error returned by ToMapStrict
+var ErrDuplicateKey = errors.New("gslice: duplicate key")
+
+// DuplicateKeyError lists the keys produced by more than one element, in the order their first duplicate was found
+//
+// it wraps ErrDuplicateKey so it can be checked with errors.Is without knowing K.
+type DuplicateKeyError[K comparable] struct {
+	Keys []K
+}
+
+func (e *DuplicateKeyError[K]) Error() string {
+	return fmt.Sprintf("%v: %v", ErrDuplicateKey, e.Keys)
+}
+
+func (e *DuplicateKeyError[K]) Unwrap() error {
+	return ErrDuplicateKey
+}
+
+// ToMapStrict is like ToMap but fails if two elements produce the same key,
+// a nil map and a *DuplicateKeyError[K] listing all duplicate keys are returned
+func ToMapStrict[T, V any, K comparable](slice []T, f func(T) (K, V)) (map[K]V, error) {
+	result := make(map[K]V, len(slice))
+	var duplicates []K
+	reported := make(map[K]struct{})
+	for _, item := range slice {
+		key, value := f(item)
+		if _, ok := result[key]; ok {
+			if _, ok := reported[key]; !ok {
+				reported[key] = struct{}{}
+				duplicates = append(duplicates, key)
+			}
+			continue
+		}
+		result[key] = value
+	}
+
+	if len(duplicates) > 0 {
+		return nil, &DuplicateKeyError[K]{Keys: duplicates}
+	}
+	return result, nil
+}
+
+// ToMapKeepFirst is like ToMap but keeps the value of the first element when two elements produce the same key
+func ToMapKeepFirst[T, V any, K comparable](slice []T, f func(T) (K, V)) map[K]V {
+	result := make(map[K]V, len(slice))
+	for _, item := range slice {
+		key, value := f(item)
+		if _, ok := result[key]; !ok {
+			result[key] = value
+		}
+	}
+	return result
+}
+
+// ToMapMerge is like ToMap but combines the values with merge when two elements produce the same key,
+// old is the value already in the map and new the value of the current element
+func ToMapMerge[T, V any, K comparable](slice []T, f func(T) (K, V), merge func(old, new V) V) map[K]V {
+	result := make(map[K]V, len(slice))
+	for _, item := range slice {
+		key, value := f(item)
+		if old, ok := result[key]; ok {
+			value = merge(old, value)
+		}
+		result[key] = value
+	}
+	return result
+}
+
+// KeyBy return a map from the key of each element to the element, the last element wins on duplicate keys
+func KeyBy[T any, K comparable](slice []T, keyFunc func(T) K) map[K]T {
+	return ToMap(slice, func(v T) (K, T) { return keyFunc(v), v })
+}
+
+// IndexBy return a map from the key of each element to its index, the last index wins on duplicate keys
+func IndexBy[T any, K comparable](slice []T, keyFunc func(T) K) map[K]int {
+	result := make(map[K]int, len(slice))
+	for i, v := range slice {
+		result[keyFunc(v)] = i
+	}
+	return result
+}
+
+// Associate return a map from each key to the value computed by f
+func Associate[K comparable, V any](keys []K, f func(K) V) map[K]V {
+	return ToMap(keys, func(k K) (K, V) { return k, f(k) })
+}
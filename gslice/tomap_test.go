@@ -0,0 +1,49 @@
+package gslice
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var tomapPeople = []Person{{"Alice", 30}, {"Bob", 25}, {"Carol", 30}, {"Dave", 25}, {"Eve", 30}}
+
+func ageToName(p Person) (int, string) { return p.Age, p.Name }
+
+func TestToMapStrict(t *testing.T) {
+	result, err := ToMapStrict(tomapPeople[:2], ageToName)
+	assert.NoError(t, err)
+	assert.Equal(t, map[int]string{30: "Alice", 25: "Bob"}, result)
+
+	result, err = ToMapStrict(tomapPeople, ageToName)
+	assert.Nil(t, result)
+	assert.True(t, errors.Is(err, ErrDuplicateKey))
+
+	var dupErr *DuplicateKeyError[int]
+	assert.True(t, errors.As(err, &dupErr))
+	assert.Equal(t, []int{30, 25}, dupErr.Keys, "each duplicate key should be listed once")
+	assert.Equal(t, "gslice: duplicate key: [30 25]", err.Error())
+
+	result, err = ToMapStrict([]Person{}, ageToName)
+	assert.NoError(t, err)
+	assert.Equal(t, map[int]string{}, result)
+}
+
+func TestToMapPolicies(t *testing.T) {
+	assert.Equal(t, map[int]string{30: "Eve", 25: "Dave"}, ToMap(tomapPeople, ageToName))
+	assert.Equal(t, map[int]string{30: "Alice", 25: "Bob"}, ToMapKeepFirst(tomapPeople, ageToName))
+
+	joined := ToMapMerge(tomapPeople, ageToName, func(old, new string) string { return old + "," + new })
+	assert.Equal(t, map[int]string{30: "Alice,Carol,Eve", 25: "Bob,Dave"}, joined)
+}
+
+func TestKeyBy(t *testing.T) {
+	byAge := func(p Person) int { return p.Age }
+	assert.Equal(t, map[int]Person{30: {"Eve", 30}, 25: {"Dave", 25}}, KeyBy(tomapPeople, byAge))
+	assert.Equal(t, map[int]int{30: 4, 25: 3}, IndexBy(tomapPeople, byAge))
+	assert.Equal(t, map[int]int{}, IndexBy([]Person{}, byAge))
+
+	lengths := Associate([]string{"a", "bc"}, func(s string) int { return len(s) })
+	assert.Equal(t, map[string]int{"a": 1, "bc": 2}, lengths)
+}
@@ -0,0 +1,192 @@
+package gslice
+
+import (
+	"sort"
+)
+
+// TopK return the k greatest elements of slice by less function, greatest first
+//
+// it runs in O(n log k) with a bounded heap, the order of equal elements is unspecified.
+func TopK[T any](slice []T, k int, less func(T, T) bool) []T {
+	return BottomK(slice, k, func(a, b T) bool { return less(b, a) })
+}
+
+// BottomK return the k smallest elements of slice by less function, smallest first
+//
+// it runs in O(n log k) with a bounded heap, the order of equal elements is unspecified.
+func BottomK[T any](slice []T, k int, less func(T, T) bool) []T {
+	if k <= 0 {
+		return []T{}
+	}
+	if k > len(slice) {
+		k = len(slice)
+	}
+
+	// h is a max-heap of the k smallest elements seen so far, h[0] is the greatest of them
+	greater := func(a, b T) bool { return less(b, a) }
+	h := make([]T, 0, k)
+	for _, v := range slice {
+		if len(h) < k {
+			h = append(h, v)
+			heapUp(h, len(h)-1, greater)
+		} else if less(v, h[0]) {
+			h[0] = v
+			heapDown(h, 0, greater)
+		}
+	}
+
+	// pop the heap from the back to get the elements in ascending order
+	for n := len(h) - 1; n > 0; n-- {
+		h[0], h[n] = h[n], h[0]
+		heapDown(h[:n], 0, greater)
+	}
+	return h
+}
+
+// MinBy return the min value of slice with its index and true, or false if slice is empty,
+// the first one is returned if there are several min values
+func MinBy[T any](slice []T, less func(T, T) bool) (T, int, bool) {
+	if len(slice) == 0 {
+		var zeroValue T
+		return zeroValue, -1, false
+	}
+
+	index := 0
+	for i := 1; i < len(slice); i++ {
+		if less(slice[i], slice[index]) {
+			index = i
+		}
+	}
+	return slice[index], index, true
+}
+
+// MaxBy return the max value of slice with its index and true, or false if slice is empty,
+// the first one is returned if there are several max values
+func MaxBy[T any](slice []T, less func(T, T) bool) (T, int, bool) {
+	if len(slice) == 0 {
+		var zeroValue T
+		return zeroValue, -1, false
+	}
+
+	index := 0
+	for i := 1; i < len(slice); i++ {
+		if less(slice[index], slice[i]) {
+			index = i
+		}
+	}
+	return slice[index], index, true
+}
+
+// NthElement return the element which would be at index n (0-based) if slice was sorted by less function,
+// or false if n is out of range
+//
+// it runs in O(n) on average with quickselect, slice is not modified.
+func NthElement[T any](slice []T, n int, less func(T, T) bool) (T, bool) {
+	if n < 0 || n >= len(slice) {
+		var zeroValue T
+		return zeroValue, false
+	}
+
+	result := make([]T, len(slice))
+	copy(result, slice)
+	quickselect(result, n, less)
+	return result[n], true
+}
+
+// PartialSort return a new slice whose first k elements are the k smallest elements of slice in sorted order,
+// the remaining elements follow in unspecified order
+func PartialSort[T any](slice []T, k int, less func(T, T) bool) []T {
+	result := make([]T, len(slice))
+	copy(result, slice)
+	if k <= 0 {
+		return result
+	}
+	if k > len(result) {
+		k = len(result)
+	}
+
+	if k < len(result) {
+		quickselect(result, k, less)
+	}
+	head := result[:k]
+	sort.Slice(head, func(i, j int) bool {
+		return less(head[i], head[j])
+	})
+	return result
+}
+
+// quickselect rearranges slice so that slice[n] is the element which would be there if slice was sorted,
+// every element before n is not greater than it and every element after n is not less than it
+func quickselect[T any](slice []T, n int, less func(T, T) bool) {
+	lo, hi := 0, len(slice)-1
+	for lo < hi {
+		// median of three as pivot, moved to hi
+		mid := lo + (hi-lo)/2
+		if less(slice[mid], slice[lo]) {
+			slice[mid], slice[lo] = slice[lo], slice[mid]
+		}
+		if less(slice[hi], slice[lo]) {
+			slice[hi], slice[lo] = slice[lo], slice[hi]
+		}
+		if less(slice[mid], slice[hi]) {
+			slice[mid], slice[hi] = slice[hi], slice[mid]
+		}
+		pivot := slice[hi]
+
+		// three-way partition: [lo, lt) < pivot, [lt, gt] == pivot, (gt, hi] > pivot
+		lt, i, gt := lo, lo, hi
+		for i <= gt {
+			switch {
+			case less(slice[i], pivot):
+				slice[lt], slice[i] = slice[i], slice[lt]
+				lt++
+				i++
+			case less(pivot, slice[i]):
+				slice[i], slice[gt] = slice[gt], slice[i]
+				gt--
+			default:
+				i++
+			}
+		}
+
+		switch {
+		case n < lt:
+			hi = lt - 1
+		case n > gt:
+			lo = gt + 1
+		default:
+			return
+		}
+	}
+}
+
+// heapUp moves h[i] up until its parent is not after it, before(a, b) reports whether a belongs above b
+func heapUp[T any](h []T, i int, before func(T, T) bool) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !before(h[i], h[parent]) {
+			return
+		}
+		h[i], h[parent] = h[parent], h[i]
+		i = parent
+	}
+}
+
+// heapDown moves h[i] down until none of its children belongs above it
+func heapDown[T any](h []T, i int, before func(T, T) bool) {
+	for {
+		top := i
+		left, right := 2*i+1, 2*i+2
+		if left < len(h) && before(h[left], h[top]) {
+			top = left
+		}
+		if right < len(h) && before(h[right], h[top]) {
+			top = right
+		}
+		if top == i {
+			return
+		}
+		h[i], h[top] = h[top], h[i]
+		i = top
+	}
+}
@@ -0,0 +1,104 @@
+package gslice
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTopK(t *testing.T) {
+	less := func(a, b int) bool { return a < b }
+	input := []int{5, 1, 9, 3, 7, 9, 2}
+
+	assert.Equal(t, []int{9, 9, 7}, TopK(input, 3, less))
+	assert.Equal(t, []int{1, 2, 3}, BottomK(input, 3, less))
+	assert.Equal(t, []int{9, 9, 7, 5, 3, 2, 1}, TopK(input, 10, less))
+	assert.Equal(t, []int{}, TopK(input, 0, less))
+	assert.Equal(t, []int{}, BottomK([]int{}, 3, less))
+	assert.Equal(t, []int{5, 1, 9, 3, 7, 9, 2}, input, "TopK should not modify the input")
+
+	people := []Person{{"Alice", 30}, {"Bob", 25}, {"Carol", 35}}
+	oldest := TopK(people, 2, func(a, b Person) bool { return a.Age < b.Age })
+	assert.Equal(t, []Person{{"Carol", 35}, {"Alice", 30}}, oldest)
+}
+
+func TestTopKRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	less := func(a, b int) bool { return a < b }
+	for i := 0; i < 100; i++ {
+		input := make([]int, r.Intn(50))
+		for j := range input {
+			input[j] = r.Intn(20)
+		}
+		k := r.Intn(10)
+
+		sorted := OrderBy(input, less)
+		expected := sorted
+		if k < len(sorted) {
+			expected = sorted[:k]
+		}
+		assert.Equal(t, expected, BottomK(input, k, less))
+	}
+}
+
+func TestMinByMaxBy(t *testing.T) {
+	less := func(a, b int) bool { return a < b }
+
+	v, i, ok := MinBy([]int{3, 1, 2, 1}, less)
+	assert.Equal(t, 1, v)
+	assert.Equal(t, 1, i)
+	assert.True(t, ok)
+
+	v, i, ok = MaxBy([]int{3, 1, 3, 2}, less)
+	assert.Equal(t, 3, v)
+	assert.Equal(t, 0, i)
+	assert.True(t, ok)
+
+	_, i, ok = MinBy([]int{}, less)
+	assert.Equal(t, -1, i)
+	assert.False(t, ok)
+	_, _, ok = MaxBy([]int{}, less)
+	assert.False(t, ok)
+}
+
+func TestNthElement(t *testing.T) {
+	less := func(a, b int) bool { return a < b }
+	input := []int{7, 2, 9, 4, 4, 1}
+
+	sorted := Sort([]int{7, 2, 9, 4, 4, 1})
+	for n := range input {
+		v, ok := NthElement(input, n, less)
+		assert.True(t, ok)
+		assert.Equal(t, sorted[n], v, "n = %d", n)
+	}
+	assert.Equal(t, []int{7, 2, 9, 4, 4, 1}, input, "NthElement should not modify the input")
+
+	_, ok := NthElement(input, 6, less)
+	assert.False(t, ok)
+	_, ok = NthElement(input, -1, less)
+	assert.False(t, ok)
+}
+
+func TestPartialSort(t *testing.T) {
+	less := func(a, b int) bool { return a < b }
+	input := []int{5, 1, 9, 3, 7, 2}
+
+	result := PartialSort(input, 3, less)
+	assert.Equal(t, []int{1, 2, 3}, result[:3])
+	assert.ElementsMatch(t, []int{5, 7, 9}, result[3:])
+	assert.Equal(t, []int{5, 1, 9, 3, 7, 2}, input)
+
+	assert.Equal(t, []int{1, 2, 3, 5, 7, 9}, PartialSort(input, 10, less))
+	assert.ElementsMatch(t, input, PartialSort(input, 0, less))
+
+	r := rand.New(rand.NewSource(1))
+	large := make([]int, 1000)
+	for i := range large {
+		large[i] = r.Intn(100)
+	}
+	result = PartialSort(large, 50, less)
+	assert.True(t, sort.IntsAreSorted(result[:50]))
+	assert.Equal(t, Sort(append([]int{}, large...))[:50], result[:50])
+}
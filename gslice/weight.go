@@ -0,0 +1,130 @@
+package gslice
+
+import (
+	"errors"
+)
+
+// OversizePolicy decides what to do with an item whose weight alone exceeds the max weight of a chunk
+type OversizePolicy int
+
+const (
+	// OversizeOwnChunk puts the item into a chunk of its own
+	OversizeOwnChunk OversizePolicy = iota
+	// OversizeError stops chunking and returns an error wrapping ErrOversizedItem
+	OversizeError
+	// OversizeDrop silently drops the item
+	OversizeDrop
+)
+
+// ErrOversizedItem is returned (wrapped in *IndexError) when an item exceeds the max weight with OversizeError policy
+var ErrOversizedItem = errors.New("gslice: item weight exceeds max weight")
+
+// WeightChunker groups items into chunks limited by total weight and count,
+// and passes each chunk to emit as soon as it's complete, so the input doesn't need to be held in memory.
+//
+// example:
+//
+//	c := NewWeightChunker(func(r Record) int { return len(r.Payload) }, 1<<20, 500, OversizeError, send)
+//	for rows.Next() {
+//		if err := c.Add(rows.Record()); err != nil {
+//			return err
+//		}
+//	}
+//	return c.Flush()
+type WeightChunker[T any] struct {
+	weight    func(T) int
+	maxWeight int
+	maxCount  int
+	policy    OversizePolicy
+	emit      func([]T) error
+
+	chunk       []T
+	chunkWeight int
+	index       int
+}
+
+// NewWeightChunker creates a WeightChunker,
+// maxWeight <= 0 means no weight limit and maxCount <= 0 means no count limit
+func NewWeightChunker[T any](weight func(T) int, maxWeight, maxCount int, policy OversizePolicy, emit func([]T) error) *WeightChunker[T] {
+	return &WeightChunker[T]{
+		weight:    weight,
+		maxWeight: maxWeight,
+		maxCount:  maxCount,
+		policy:    policy,
+		emit:      emit,
+	}
+}
+
+// Add adds an item to the current chunk, the current chunk is emitted first if the item doesn't fit in it
+func (c *WeightChunker[T]) Add(v T) error {
+	index := c.index
+	c.index++
+
+	w := c.weight(v)
+	if c.maxWeight > 0 && w > c.maxWeight {
+		switch c.policy {
+		case OversizeError:
+			return &IndexError{Index: index, Err: ErrOversizedItem}
+		case OversizeDrop:
+			return nil
+		default:
+			if err := c.Flush(); err != nil {
+				return err
+			}
+			return c.emit([]T{v})
+		}
+	}
+
+	full := c.maxCount > 0 && len(c.chunk) >= c.maxCount
+	heavy := c.maxWeight > 0 && c.chunkWeight+w > c.maxWeight
+	if len(c.chunk) > 0 && (full || heavy) {
+		if err := c.Flush(); err != nil {
+			return err
+		}
+	}
+
+	c.chunk = append(c.chunk, v)
+	c.chunkWeight += w
+	return nil
+}
+
+// Flush emits the current chunk if it's not empty
+func (c *WeightChunker[T]) Flush() error {
+	if len(c.chunk) == 0 {
+		return nil
+	}
+
+	chunk := c.chunk
+	c.chunk = nil
+	c.chunkWeight = 0
+	return c.emit(chunk)
+}
+
+// ChunkByWeight divides the slice into chunks whose total weight is at most maxWeight
+// and which contain at most maxCount elements
+//
+// maxWeight <= 0 means no weight limit and maxCount <= 0 means no count limit.
+// an element heavier than maxWeight is handled according to policy.
+func ChunkByWeight[T any](slice []T, weight func(T) int, maxWeight, maxCount int, policy OversizePolicy) ([][]T, error) {
+	result := make([][]T, 0)
+	err := ChunkByWeightFunc(slice, weight, maxWeight, maxCount, policy, func(chunk []T) error {
+		result = append(result, chunk)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ChunkByWeightFunc is like ChunkByWeight but passes each chunk to emit instead of collecting them,
+// it stops at the first error returned by emit
+func ChunkByWeightFunc[T any](slice []T, weight func(T) int, maxWeight, maxCount int, policy OversizePolicy, emit func([]T) error) error {
+	c := NewWeightChunker(weight, maxWeight, maxCount, policy, emit)
+	for _, v := range slice {
+		if err := c.Add(v); err != nil {
+			return err
+		}
+	}
+	return c.Flush()
+}
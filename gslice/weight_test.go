@@ -0,0 +1,82 @@
+package gslice
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChunkByWeight(t *testing.T) {
+	identityWeight := func(v int) int { return v }
+
+	tests := []struct {
+		name      string
+		input     []int
+		maxWeight int
+		maxCount  int
+		policy    OversizePolicy
+		expected  [][]int
+	}{
+		{"weight only", []int{1, 2, 3, 4, 5}, 5, 0, OversizeOwnChunk, [][]int{{1, 2}, {3}, {4}, {5}}},
+		{"count only", []int{1, 2, 3, 4, 5}, 0, 2, OversizeOwnChunk, [][]int{{1, 2}, {3, 4}, {5}}},
+		{"weight and count", []int{1, 1, 1, 1, 3}, 4, 3, OversizeOwnChunk, [][]int{{1, 1, 1}, {1, 3}}},
+		{"oversize own chunk", []int{1, 10, 2}, 5, 0, OversizeOwnChunk, [][]int{{1}, {10}, {2}}},
+		{"oversize drop", []int{1, 10, 2}, 5, 0, OversizeDrop, [][]int{{1, 2}}},
+		{"empty", []int{}, 5, 0, OversizeOwnChunk, [][]int{}},
+	}
+	for _, tt := range tests {
+		result, err := ChunkByWeight(tt.input, identityWeight, tt.maxWeight, tt.maxCount, tt.policy)
+		assert.NoError(t, err, tt.name)
+		assert.Equal(t, tt.expected, result, tt.name)
+	}
+}
+
+func TestChunkByWeightOversizeError(t *testing.T) {
+	result, err := ChunkByWeight([]string{"ab", "abcdef", "a"}, func(s string) int { return len(s) }, 4, 0, OversizeError)
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, ErrOversizedItem)
+
+	var indexErr *IndexError
+	if assert.ErrorAs(t, err, &indexErr) {
+		assert.Equal(t, 1, indexErr.Index)
+	}
+}
+
+func TestChunkByWeightFunc(t *testing.T) {
+	var chunks [][]int
+	err := ChunkByWeightFunc([]int{1, 2, 3, 4}, func(v int) int { return 1 }, 0, 3, OversizeOwnChunk, func(chunk []int) error {
+		chunks = append(chunks, chunk)
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, [][]int{{1, 2, 3}, {4}}, chunks)
+
+	boom := errors.New("boom")
+	calls := 0
+	err = ChunkByWeightFunc([]int{1, 2, 3, 4}, func(v int) int { return 1 }, 0, 1, OversizeOwnChunk, func(chunk []int) error {
+		calls++
+		return boom
+	})
+	assert.ErrorIs(t, err, boom)
+	assert.Equal(t, 1, calls)
+}
+
+func TestWeightChunker(t *testing.T) {
+	var chunks [][]string
+	c := NewWeightChunker(func(s string) int { return len(s) }, 6, 0, OversizeOwnChunk, func(chunk []string) error {
+		chunks = append(chunks, chunk)
+		return nil
+	})
+
+	for _, s := range []string{"abc", "de", "fgh", "ijklmnop", "q"} {
+		assert.NoError(t, c.Add(s))
+	}
+	assert.Equal(t, [][]string{{"abc", "de"}, {"fgh"}, {"ijklmnop"}}, chunks)
+
+	assert.NoError(t, c.Flush())
+	assert.Equal(t, [][]string{{"abc", "de"}, {"fgh"}, {"ijklmnop"}, {"q"}}, chunks)
+
+	assert.NoError(t, c.Flush())
+	assert.Len(t, chunks, 4)
+}
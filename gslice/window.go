@@ -0,0 +1,162 @@
+package gslice
+
+// Pair is a tuple of two values
+type Pair[A, B any] struct {
+	First  A
+	Second B
+}
+
+// Triple is a tuple of three values
+type Triple[A, B, C any] struct {
+	First  A
+	Second B
+	Third  C
+}
+
+// SlidingWindow return all windows of 'size' consecutive elements, starting a new window every 'step' elements
+//
+// a trailing window with less than 'size' elements is not returned.
+// windows share the backing array of slice and are capped at their length,
+// so appending to a window never overwrites the next elements of slice.
+func SlidingWindow[T any](slice []T, size, step int) [][]T {
+	if size <= 0 || step <= 0 || len(slice) < size {
+		return [][]T{}
+	}
+
+	result := make([][]T, 0, (len(slice)-size)/step+1)
+	for i := 0; i+size <= len(slice); i += step {
+		result = append(result, slice[i:i+size:i+size])
+	}
+	return result
+}
+
+// Pairwise return pairs of consecutive elements, e.g. [1, 2, 3] => [(1, 2), (2, 3)]
+func Pairwise[T any](slice []T) []Pair[T, T] {
+	if len(slice) < 2 {
+		return []Pair[T, T]{}
+	}
+
+	result := make([]Pair[T, T], len(slice)-1)
+	for i := range result {
+		result[i] = Pair[T, T]{First: slice[i], Second: slice[i+1]}
+	}
+	return result
+}
+
+// Zip pairs the elements of a and b by index, the result is as long as the shorter slice
+func Zip[A, B any](a []A, b []B) []Pair[A, B] {
+	n := len(a)
+	if len(b) < n {
+		n = len(b)
+	}
+
+	result := make([]Pair[A, B], n)
+	for i := range result {
+		result[i] = Pair[A, B]{First: a[i], Second: b[i]}
+	}
+	return result
+}
+
+// Zip3 groups the elements of a, b and c by index, the result is as long as the shortest slice
+func Zip3[A, B, C any](a []A, b []B, c []C) []Triple[A, B, C] {
+	n := len(a)
+	if len(b) < n {
+		n = len(b)
+	}
+	if len(c) < n {
+		n = len(c)
+	}
+
+	result := make([]Triple[A, B, C], n)
+	for i := range result {
+		result[i] = Triple[A, B, C]{First: a[i], Second: b[i], Third: c[i]}
+	}
+	return result
+}
+
+// ZipLongest pairs the elements of a and b by index, the result is as long as the longer slice
+// and the missing elements are replaced by fillA and fillB
+func ZipLongest[A, B any](a []A, b []B, fillA A, fillB B) []Pair[A, B] {
+	n := len(a)
+	if len(b) > n {
+		n = len(b)
+	}
+
+	result := make([]Pair[A, B], n)
+	for i := range result {
+		result[i] = Pair[A, B]{First: fillA, Second: fillB}
+		if i < len(a) {
+			result[i].First = a[i]
+		}
+		if i < len(b) {
+			result[i].Second = b[i]
+		}
+	}
+	return result
+}
+
+// Unzip splits a slice of pairs into two slices
+func Unzip[A, B any](pairs []Pair[A, B]) ([]A, []B) {
+	as := make([]A, len(pairs))
+	bs := make([]B, len(pairs))
+	for i, p := range pairs {
+		as[i], bs[i] = p.First, p.Second
+	}
+	return as, bs
+}
+
+// Unzip3 splits a slice of triples into three slices
+func Unzip3[A, B, C any](triples []Triple[A, B, C]) ([]A, []B, []C) {
+	as := make([]A, len(triples))
+	bs := make([]B, len(triples))
+	cs := make([]C, len(triples))
+	for i, t := range triples {
+		as[i], bs[i], cs[i] = t.First, t.Second, t.Third
+	}
+	return as, bs, cs
+}
+
+// Interleave takes one element from each slice in turn and stops as soon as any slice is exhausted,
+// e.g. [1, 2, 3], [a, b] => [1, a, 2, b]
+func Interleave[T any](slices ...[]T) []T {
+	if len(slices) == 0 {
+		return []T{}
+	}
+
+	n := len(slices[0])
+	for _, s := range slices {
+		if len(s) < n {
+			n = len(s)
+		}
+	}
+
+	result := make([]T, 0, n*len(slices))
+	for i := 0; i < n; i++ {
+		for _, s := range slices {
+			result = append(result, s[i])
+		}
+	}
+	return result
+}
+
+// RoundRobin takes one element from each slice in turn, skipping the exhausted ones until all slices are consumed,
+// e.g. [1, 2, 3], [a, b] => [1, a, 2, b, 3]
+func RoundRobin[T any](slices ...[]T) []T {
+	total, longest := 0, 0
+	for _, s := range slices {
+		total += len(s)
+		if len(s) > longest {
+			longest = len(s)
+		}
+	}
+
+	result := make([]T, 0, total)
+	for i := 0; i < longest; i++ {
+		for _, s := range slices {
+			if i < len(s) {
+				result = append(result, s[i])
+			}
+		}
+	}
+	return result
+}
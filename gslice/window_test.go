@@ -0,0 +1,85 @@
+package gslice
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSlidingWindow(t *testing.T) {
+	input := []int{1, 2, 3, 4, 5}
+	tests := []struct {
+		size, step int
+		expected   [][]int
+	}{
+		{3, 1, [][]int{{1, 2, 3}, {2, 3, 4}, {3, 4, 5}}},
+		{2, 2, [][]int{{1, 2}, {3, 4}}},
+		{2, 3, [][]int{{1, 2}, {4, 5}}},
+		{5, 1, [][]int{{1, 2, 3, 4, 5}}},
+		{6, 1, [][]int{}},
+		{0, 1, [][]int{}},
+		{2, 0, [][]int{}},
+	}
+	for _, tt := range tests {
+		result := SlidingWindow(input, tt.size, tt.step)
+		if !reflect.DeepEqual(result, tt.expected) {
+			t.Errorf("SlidingWindow(%d, %d) = %v, expected %v", tt.size, tt.step, result, tt.expected)
+		}
+	}
+}
+
+func TestSlidingWindowAppendDoesNotOverwrite(t *testing.T) {
+	input := []int{1, 2, 3, 4}
+	windows := SlidingWindow(input, 2, 1)
+	_ = append(windows[0], 100)
+	assert.Equal(t, []int{1, 2, 3, 4}, input)
+}
+
+func TestPairwise(t *testing.T) {
+	expected := []Pair[int, int]{{1, 2}, {2, 3}}
+	assert.Equal(t, expected, Pairwise([]int{1, 2, 3}))
+	assert.Equal(t, []Pair[int, int]{}, Pairwise([]int{1}))
+}
+
+func TestZip(t *testing.T) {
+	pairs := Zip([]int{1, 2, 3}, []string{"a", "b"})
+	assert.Equal(t, []Pair[int, string]{{1, "a"}, {2, "b"}}, pairs)
+
+	nums, strs := Unzip(pairs)
+	assert.Equal(t, []int{1, 2}, nums)
+	assert.Equal(t, []string{"a", "b"}, strs)
+
+	assert.Equal(t, []Pair[int, string]{}, Zip([]int{}, []string{"a"}))
+}
+
+func TestZip3(t *testing.T) {
+	triples := Zip3([]int{1, 2}, []string{"a", "b", "c"}, []bool{true, false})
+	assert.Equal(t, []Triple[int, string, bool]{{1, "a", true}, {2, "b", false}}, triples)
+
+	a, b, c := Unzip3(triples)
+	assert.Equal(t, []int{1, 2}, a)
+	assert.Equal(t, []string{"a", "b"}, b)
+	assert.Equal(t, []bool{true, false}, c)
+}
+
+func TestZipLongest(t *testing.T) {
+	result := ZipLongest([]int{1, 2, 3}, []string{"a"}, -1, "?")
+	assert.Equal(t, []Pair[int, string]{{1, "a"}, {2, "?"}, {3, "?"}}, result)
+
+	result = ZipLongest([]int{}, []string{"a", "b"}, -1, "?")
+	assert.Equal(t, []Pair[int, string]{{-1, "a"}, {-1, "b"}}, result)
+}
+
+func TestInterleave(t *testing.T) {
+	assert.Equal(t, []int{1, 10, 2, 20}, Interleave([]int{1, 2, 3}, []int{10, 20}))
+	assert.Equal(t, []int{1, 10, 100}, Interleave([]int{1}, []int{10}, []int{100, 200}))
+	assert.Equal(t, []int{}, Interleave[int]())
+	assert.Equal(t, []int{}, Interleave([]int{1}, []int{}))
+}
+
+func TestRoundRobin(t *testing.T) {
+	assert.Equal(t, []int{1, 10, 2, 20, 3}, RoundRobin([]int{1, 2, 3}, []int{10, 20}))
+	assert.Equal(t, []int{1, 100, 2, 3}, RoundRobin([]int{1, 2, 3}, []int{}, []int{100}))
+	assert.Equal(t, []int{}, RoundRobin[int]())
+}